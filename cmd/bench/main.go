@@ -0,0 +1,234 @@
+// Command bench times every registered sorting variant on inputs of
+// increasing size drawn from each distribution in package gen, and writes
+// a CSV or JSON table of ns/op, allocations and comparisons.
+//
+// The quadratic variants cannot finish the larger sizes, so for each variant
+// and distribution bench extrapolates the time of the next size from the
+// sizes already run and skips it (and every size after it) once the estimate
+// exceeds -budget.
+//
+// Usage:
+//
+//	bench > results.csv
+//	bench -algo quick,merge -dist random,sorted -sizes 1000,1000000 -format json
+//	bench -algo quick -baseline Pdqsort
+//	bench -algo MergeSortParallel -baseline MergeSortBasic -procs 1,2,4,8
+//
+// With -baseline, every row also gets its ns/op relative to the named
+// variant's on the same distribution, size and -procs setting, so that e.g.
+// the quicksort variants can be read off against pdqsort.
+//
+// With -procs, everything is run once for each GOMAXPROCS value listed. Run
+// against a sequential baseline, the inverse of the relative column is then
+// the speedup of a parallel variant over it for each number of cores.
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/RaviParvadiya/learn_dsa/gen"
+	"github.com/RaviParvadiya/learn_dsa/sorting"
+)
+
+func main() {
+	algos := flag.String("algo", "", "comma-separated variant names or families to run (default all)")
+	dists := flag.String("dist", "", "comma-separated distributions to run (default all)")
+	sizes := flag.String("sizes", "10,100,1000,10000,100000,1000000,10000000", "comma-separated input sizes")
+	format := flag.String("format", "csv", "output format: csv or json")
+	out := flag.String("o", "", "write the table to this file instead of stdout")
+	budget := flag.Duration("budget", 2*time.Second, "skip sizes expected to take longer than this per sort")
+	benchtime := flag.Duration("benchtime", 200*time.Millisecond, "minimum time to spend timing each size")
+	seed := flag.Int64("seed", 1, "random seed for the inputs")
+	baseline := flag.String("baseline", "", "variant to report every ns/op relative to")
+	procs := flag.String("procs", "", "comma-separated GOMAXPROCS values to run with (default the current one)")
+	flag.Parse()
+
+	cfg := config{budget: *budget, benchtime: *benchtime, seed: *seed}
+	var err error
+	if cfg.algos, err = selectAlgorithms(*algos); err == nil {
+		if cfg.dists, err = selectDistributions(*dists); err == nil {
+			if cfg.sizes, err = parseSizes(*sizes); err == nil && *procs != "" {
+				cfg.procs, err = parseProcs(*procs)
+			}
+		}
+	}
+	if err == nil && *baseline != "" {
+		a, ok := sorting.Lookup(*baseline)
+		if !ok {
+			err = fmt.Errorf("unknown baseline variant %q", *baseline)
+		} else if !slices.ContainsFunc(cfg.algos, func(b sorting.Algorithm) bool { return b.Name == a.Name }) {
+			cfg.algos = append(cfg.algos, a)
+		}
+		*baseline = a.Name
+	}
+	if err == nil && *format != "csv" && *format != "json" {
+		err = fmt.Errorf("unknown format %q", *format)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "bench:", err)
+		os.Exit(2)
+	}
+
+	results := run(cfg, os.Stderr)
+	if *baseline != "" {
+		relativeTo(results, *baseline)
+	}
+
+	w := io.Writer(os.Stdout)
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "bench:", err)
+			os.Exit(1)
+		}
+		defer f.Close()
+		w = f
+	}
+	if *format == "json" {
+		err = writeJSON(w, results)
+	} else {
+		err = writeCSV(w, results, *baseline != "")
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "bench:", err)
+		os.Exit(1)
+	}
+}
+
+// selectAlgorithms returns the variants named by list, where each entry is a
+// variant name or a family, or every variant if list is empty.
+func selectAlgorithms(list string) ([]sorting.Algorithm, error) {
+	all := sorting.Algorithms()
+	if list == "" {
+		return all, nil
+	}
+	var algos []sorting.Algorithm
+	for _, name := range strings.Split(list, ",") {
+		name = strings.TrimSpace(name)
+		if a, ok := sorting.Lookup(name); ok {
+			algos = append(algos, a)
+			continue
+		}
+		found := false
+		for _, a := range all {
+			if strings.EqualFold(a.Family, name) {
+				algos = append(algos, a)
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown variant or family %q", name)
+		}
+	}
+	return algos, nil
+}
+
+// selectDistributions returns the distributions named by list, or every
+// distribution if list is empty.
+func selectDistributions(list string) ([]gen.Distribution, error) {
+	if list == "" {
+		return gen.Distributions(), nil
+	}
+	var dists []gen.Distribution
+	for _, name := range strings.Split(list, ",") {
+		d, ok := gen.Lookup(strings.TrimSpace(name))
+		if !ok {
+			return nil, fmt.Errorf("unknown distribution %q", name)
+		}
+		dists = append(dists, d)
+	}
+	return dists, nil
+}
+
+func parseSizes(list string) ([]int, error) {
+	var sizes []int
+	for _, s := range strings.Split(list, ",") {
+		n, err := strconv.Atoi(strings.TrimSpace(s))
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("bad size %q", s)
+		}
+		sizes = append(sizes, n)
+	}
+	return sizes, nil
+}
+
+func parseProcs(list string) ([]int, error) {
+	var procs []int
+	for _, s := range strings.Split(list, ",") {
+		n, err := strconv.Atoi(strings.TrimSpace(s))
+		if err != nil || n < 1 {
+			return nil, fmt.Errorf("bad GOMAXPROCS value %q", s)
+		}
+		procs = append(procs, n)
+	}
+	return procs, nil
+}
+
+var header = []string{"algorithm", "family", "distribution", "n", "procs", "ns_per_op", "allocs_per_op", "bytes_per_op", "comparisons", "iterations"}
+
+// relativeTo sets the Relative field of every result to its ns/op divided
+// by that of the baseline variant on the same distribution, size and
+// GOMAXPROCS.
+func relativeTo(results []result, baseline string) {
+	type key struct {
+		dist  string
+		n     int
+		procs int
+	}
+	base := make(map[key]float64)
+	for _, r := range results {
+		if r.Algorithm == baseline {
+			base[key{r.Distribution, r.N, r.Procs}] = r.NsPerOp
+		}
+	}
+	for i, r := range results {
+		if b := base[key{r.Distribution, r.N, r.Procs}]; b > 0 {
+			results[i].Relative = r.NsPerOp / b
+		}
+	}
+}
+
+func writeCSV(w io.Writer, results []result, relative bool) error {
+	cw := csv.NewWriter(w)
+	if relative {
+		cw.Write(append(header, "relative"))
+	} else {
+		cw.Write(header)
+	}
+	for _, r := range results {
+		row := []string{
+			r.Algorithm, r.Family, r.Distribution,
+			strconv.Itoa(r.N),
+			strconv.Itoa(r.Procs),
+			strconv.FormatFloat(r.NsPerOp, 'f', 1, 64),
+			strconv.FormatFloat(r.AllocsPerOp, 'f', 1, 64),
+			strconv.FormatFloat(r.BytesPerOp, 'f', 1, 64),
+			strconv.Itoa(r.Comparisons),
+			strconv.Itoa(r.Iterations),
+		}
+		if relative {
+			row = append(row, strconv.FormatFloat(r.Relative, 'f', 2, 64))
+		}
+		cw.Write(row)
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func writeJSON(w io.Writer, results []result) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if results == nil {
+		results = []result{}
+	}
+	return enc.Encode(results)
+}
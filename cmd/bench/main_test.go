@@ -0,0 +1,69 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"slices"
+	"testing"
+)
+
+// TestRelativeTo checks that each row is divided by the baseline's ns/op on
+// the same distribution, size and GOMAXPROCS, and left unset without one.
+func TestRelativeTo(t *testing.T) {
+	results := []result{
+		{Algorithm: "Base", Distribution: "random", N: 100, Procs: 1, NsPerOp: 200},
+		{Algorithm: "Fast", Distribution: "random", N: 100, Procs: 1, NsPerOp: 50},
+		{Algorithm: "Slow", Distribution: "random", N: 100, Procs: 1, NsPerOp: 600},
+		{Algorithm: "Base", Distribution: "random", N: 100, Procs: 4, NsPerOp: 100},
+		{Algorithm: "Fast", Distribution: "random", N: 100, Procs: 4, NsPerOp: 50},
+		{Algorithm: "Base", Distribution: "sorted", N: 100, Procs: 1, NsPerOp: 10},
+		{Algorithm: "Fast", Distribution: "sorted", N: 100, Procs: 1, NsPerOp: 30},
+		{Algorithm: "Fast", Distribution: "sorted", N: 1000, Procs: 1, NsPerOp: 300},
+	}
+	relativeTo(results, "Base")
+	want := []float64{1, 0.25, 3, 1, 0.5, 1, 3, 0}
+	for i, r := range results {
+		if r.Relative != want[i] {
+			t.Errorf("%s on %s/%d with %d procs: relative %g, want %g", r.Algorithm, r.Distribution, r.N, r.Procs, r.Relative, want[i])
+		}
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	results := []result{
+		{Algorithm: "Base", Family: "quick", Distribution: "random", N: 100, Procs: 1, NsPerOp: 200, Comparisons: 700, Iterations: 10},
+		{Algorithm: "Fast", Family: "quick", Distribution: "random", N: 100, Procs: 1, NsPerOp: 50, Comparisons: 600, Iterations: 40},
+	}
+	relativeTo(results, "Base")
+	for _, relative := range []bool{false, true} {
+		var buf bytes.Buffer
+		if err := writeCSV(&buf, results, relative); err != nil {
+			t.Fatal(err)
+		}
+		rows, err := csv.NewReader(&buf).ReadAll()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(rows) != 1+len(results) {
+			t.Fatalf("relative %t: %d rows, want %d", relative, len(rows), 1+len(results))
+		}
+		cols := len(header)
+		if relative {
+			cols++
+		}
+		for i, row := range rows {
+			if len(row) != cols {
+				t.Errorf("relative %t: row %d has %d columns, want %d", relative, i, len(row), cols)
+			}
+		}
+		if !relative {
+			continue
+		}
+		if got := rows[0][cols-1]; got != "relative" {
+			t.Errorf("last column is %q, want relative", got)
+		}
+		if got := []string{rows[1][cols-1], rows[2][cols-1]}; !slices.Equal(got, []string{"1.00", "0.25"}) {
+			t.Errorf("relative column %q, want 1.00 and 0.25", got)
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"runtime"
+	"slices"
+	"time"
+
+	"github.com/RaviParvadiya/learn_dsa/gen"
+	"github.com/RaviParvadiya/learn_dsa/instrument"
+	"github.com/RaviParvadiya/learn_dsa/sorting"
+)
+
+// config is what to run and for how long.
+type config struct {
+	algos     []sorting.Algorithm
+	dists     []gen.Distribution
+	sizes     []int
+	procs     []int // GOMAXPROCS values to run with, or none for the current one
+	budget    time.Duration
+	benchtime time.Duration
+	seed      int64
+}
+
+// result is one row of the output table.
+type result struct {
+	Algorithm    string  `json:"algorithm"`
+	Family       string  `json:"family"`
+	Distribution string  `json:"distribution"`
+	N            int     `json:"n"`
+	Procs        int     `json:"procs"`
+	NsPerOp      float64 `json:"ns_per_op"`
+	AllocsPerOp  float64 `json:"allocs_per_op"`
+	BytesPerOp   float64 `json:"bytes_per_op"`
+	Comparisons  int     `json:"comparisons"`
+	Iterations   int     `json:"iterations"`
+	Relative     float64 `json:"relative,omitempty"` // ns/op over the baseline's, with -baseline
+}
+
+// point is the measured cost of one size.
+type point struct {
+	n           int
+	nsPerOp     float64
+	comparisons int
+}
+
+// batchElems bounds the number of elements copied ahead of a timed batch.
+const batchElems = 1 << 22
+
+// run measures every variant, distribution and size of cfg with each
+// GOMAXPROCS setting, logging progress and skipped sizes to log.
+func run(cfg config, log io.Writer) []result {
+	procs := cfg.procs
+	if len(procs) == 0 {
+		procs = []int{runtime.GOMAXPROCS(0)}
+	}
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(0))
+
+	var results []result
+	for _, n := range procs {
+		runtime.GOMAXPROCS(n)
+		for _, r := range runProcs(cfg, log) {
+			r.Procs = n
+			results = append(results, r)
+		}
+	}
+	return results
+}
+
+// runProcs measures every variant, distribution and size of cfg.
+func runProcs(cfg config, log io.Writer) []result {
+	sizes := slices.Clone(cfg.sizes)
+	slices.Sort(sizes)
+
+	var results []result
+	for _, d := range cfg.dists {
+		history := make([][]point, len(cfg.algos))
+		skipped := make([]bool, len(cfg.algos))
+		for _, n := range sizes {
+			input := d.Generate(n, rand.New(rand.NewSource(cfg.seed)))
+			for i, a := range cfg.algos {
+				if skipped[i] {
+					continue
+				}
+				if est := estimate(history[i], n); est > float64(cfg.budget) {
+					fmt.Fprintf(log, "skip %s on %s from n=%d: about %v per sort\n",
+						a.Name, d.Name, n, time.Duration(est).Round(time.Millisecond))
+					skipped[i] = true
+					continue
+				}
+				r := measure(a, input, cfg.benchtime, log)
+				r.Distribution = d.Name
+				results = append(results, r)
+				history[i] = append(history[i], point{n, r.NsPerOp, r.Comparisons})
+				fmt.Fprintf(log, "%-30s %-14s %9d %3d procs %14.0f ns/op\n", a.Name, d.Name, n, runtime.GOMAXPROCS(0), r.NsPerOp)
+			}
+		}
+	}
+	return results
+}
+
+// estimate predicts the ns per sort of n elements by extending the growth
+// between the last two sizes measured, assuming at least linear growth.
+// After a single size it takes the growth to be n^e for the e that makes
+// n^e the number of comparisons made, which tells n^2 sorts from n log n
+// ones.
+func estimate(history []point, n int) float64 {
+	if len(history) == 0 {
+		return 0
+	}
+	last := history[len(history)-1]
+	if last.n <= 1 || n <= last.n {
+		return last.nsPerOp
+	}
+	exp := 1.0
+	if len(history) >= 2 {
+		prev := history[len(history)-2]
+		if prev.n > 0 && prev.nsPerOp > 0 && last.n > prev.n {
+			exp = max(exp, math.Log(last.nsPerOp/prev.nsPerOp)/math.Log(float64(last.n)/float64(prev.n)))
+		}
+	} else if last.comparisons > 0 {
+		exp = max(exp, math.Log(float64(last.comparisons))/math.Log(float64(last.n)))
+	}
+	return last.nsPerOp * math.Pow(float64(n)/float64(last.n), exp)
+}
+
+// measure counts the comparisons a makes sorting input, then times it on
+// fresh copies of input, raising the number of sorts until they take at
+// least benchtime.
+func measure(a sorting.Algorithm, input []int, benchtime time.Duration, log io.Writer) result {
+	arr := slices.Clone(input)
+	var p instrument.Probe
+	a.Sort(arr, &p)
+	if !slices.IsSorted(arr) {
+		fmt.Fprintf(log, "warning: %s did not sort %d values\n", a.Name, len(input))
+	}
+
+	r := result{Algorithm: a.Name, Family: a.Family, N: len(input), Comparisons: p.Comparisons}
+	for iters := 1; ; {
+		elapsed, allocs, bytes := timeSorts(a, input, iters)
+		r.Iterations = iters
+		r.NsPerOp = float64(elapsed.Nanoseconds()) / float64(iters)
+		r.AllocsPerOp = float64(allocs) / float64(iters)
+		r.BytesPerOp = float64(bytes) / float64(iters)
+		if elapsed >= benchtime {
+			return r
+		}
+		// Aim 20% past benchtime, growing by at most 100x per round.
+		next := int(1.2 * float64(benchtime) / max(r.NsPerOp, 1))
+		iters = min(max(next, iters+1), 100*iters)
+	}
+}
+
+// timeSorts sorts iters fresh copies of input with a and returns the time
+// spent sorting along with the number and size of the allocations made.
+// Copies are made in untimed batches.
+func timeSorts(a sorting.Algorithm, input []int, iters int) (elapsed time.Duration, allocs, bytes uint64) {
+	per := max(batchElems/max(len(input), 1), 1)
+	var before, after runtime.MemStats
+	for done := 0; done < iters; {
+		batch := make([][]int, min(per, iters-done))
+		for i := range batch {
+			batch[i] = slices.Clone(input)
+		}
+		runtime.ReadMemStats(&before)
+		start := time.Now()
+		for _, arr := range batch {
+			a.Sort(arr, nil)
+		}
+		elapsed += time.Since(start)
+		runtime.ReadMemStats(&after)
+		allocs += after.Mallocs - before.Mallocs
+		bytes += after.TotalAlloc - before.TotalAlloc
+		done += len(batch)
+	}
+	return elapsed, allocs, bytes
+}
@@ -0,0 +1,242 @@
+// Command visualize runs a registered sorting variant on a generated slice
+// and animates it in the terminal as a bar chart.
+//
+// Bars being compared are drawn yellow, bars just swapped or written red, and
+// bars outside the range being split or merged gray. While it plays:
+//
+//	space   pause or resume
+//	n       step one frame while paused
+//	+ -     speed up or slow down
+//	q       quit
+//
+// With -gif or -svg it writes the run to an animated GIF or an SVG filmstrip
+// of key frames instead, without touching the terminal.
+//
+// Usage:
+//
+//	visualize -algo QuicksortDualPivot -n 48 -dist random
+//	visualize -algo QuicksortHoareMidPivot -n 64 -gif hoare.gif -svg hoare.svg
+//	visualize -list
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"os/signal"
+	"time"
+
+	"github.com/RaviParvadiya/learn_dsa/gen"
+	"github.com/RaviParvadiya/learn_dsa/instrument"
+	"github.com/RaviParvadiya/learn_dsa/sorting"
+	"github.com/RaviParvadiya/learn_dsa/trace"
+	"github.com/RaviParvadiya/learn_dsa/visualize"
+)
+
+func main() {
+	algo := flag.String("algo", "QuicksortDualPivot", "sorting variant to run, see -list")
+	n := flag.Int("n", 48, "number of values to sort")
+	dist := flag.String("dist", "random", "input distribution, see -list")
+	seed := flag.Int64("seed", 1, "random seed for the input")
+	delay := flag.Duration("delay", 30*time.Millisecond, "pause between frames")
+	height := flag.Int("height", 20, "height of the chart in rows")
+	compares := flag.Bool("compares", true, "show compare events as frames")
+	gifPath := flag.String("gif", "", "write an animated GIF to this file instead of animating")
+	svgPath := flag.String("svg", "", "write an SVG filmstrip to this file instead of animating")
+	frames := flag.Int("frames", 0, "most frames to export with -gif or -svg (default 300 and 12)")
+	list := flag.Bool("list", false, "list the sorting variants and distributions and exit")
+	flag.Parse()
+
+	if *list {
+		fmt.Println("Sorting variants:")
+		for _, a := range sorting.Algorithms() {
+			fmt.Printf("  %-32s %s\n", a.Name, a.Family)
+		}
+		fmt.Println("\nDistributions:")
+		for _, d := range gen.Distributions() {
+			fmt.Printf("  %s\n", d.Name)
+		}
+		return
+	}
+	if *n < 0 || *height <= 0 {
+		fmt.Fprintln(os.Stderr, "visualize: -n must not be negative and -height must be positive")
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	t, stats, err := record(*algo, *dist, *n, *seed)
+	if err == nil {
+		if *gifPath != "" || *svgPath != "" {
+			opts := visualize.Options{Delay: *delay, Frames: *frames, Compares: *compares}
+			err = export(t, *gifPath, *svgPath, opts)
+		} else {
+			err = animate(t, stats, *delay, *height, *compares)
+		}
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "visualize:", err)
+		os.Exit(1)
+	}
+}
+
+// record generates the input and records the run of the named variant.
+func record(algo, dist string, n int, seed int64) (*trace.Trace, instrument.Stats, error) {
+	a, ok := sorting.Lookup(algo)
+	if !ok {
+		return nil, instrument.Stats{}, fmt.Errorf("unknown sorting variant %q", algo)
+	}
+	input, err := gen.Ints(dist, n, seed)
+	if err != nil {
+		return nil, instrument.Stats{}, err
+	}
+	t, stats := trace.Record(a.Name, input, a.Sort)
+	return t, stats, nil
+}
+
+// export writes t to the GIF and SVG files named, skipping empty names.
+func export(t *trace.Trace, gifPath, svgPath string, opts visualize.Options) error {
+	for _, out := range []struct {
+		path  string
+		write func(io.Writer, *trace.Trace, visualize.Options) error
+	}{
+		{gifPath, visualize.WriteGIF},
+		{svgPath, visualize.WriteSVG},
+	} {
+		if out.path == "" {
+			continue
+		}
+		f, err := os.Create(out.path)
+		if err != nil {
+			return err
+		}
+		err = out.write(f, t, opts)
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			return err
+		}
+		fmt.Printf("wrote %s\n", out.path)
+	}
+	return nil
+}
+
+// animate plays t in the terminal.
+func animate(t *trace.Trace, stats instrument.Stats, delay time.Duration, height int, compares bool) error {
+	pl := &player{delay: delay, keys: readKeys()}
+	if pl.keys != nil {
+		defer restoreTerminal()
+	}
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+	pl.interrupt = interrupt
+
+	term := visualize.NewTerminal(os.Stdout, t, height)
+	if err := term.Start(); err != nil {
+		return err
+	}
+	defer term.Stop()
+
+	err := visualize.Each(t, compares, func(f visualize.Frame) bool {
+		term.Draw(f, pl.status())
+		pl.wait()
+		return !pl.quit
+	})
+	if err != nil || pl.quit {
+		return err
+	}
+	fmt.Printf("\n%d comparisons, %d swaps, %d writes, max depth %d\n",
+		stats.Comparisons, stats.Swaps, stats.Writes, stats.MaxDepth)
+	return nil
+}
+
+// player paces the animation and reacts to keys.
+type player struct {
+	delay     time.Duration
+	paused    bool
+	quit      bool
+	keys      <-chan byte // nil if keys could not be read
+	interrupt <-chan os.Signal
+}
+
+func (pl *player) status() string {
+	state := "playing"
+	if pl.paused {
+		state = "paused"
+	}
+	if pl.keys == nil {
+		return fmt.Sprintf("%s, delay %v", state, pl.delay)
+	}
+	return fmt.Sprintf("%s, delay %v   [space] pause  [n] step  [+/-] speed  [q] quit", state, pl.delay)
+}
+
+// wait blocks until the next frame is due: after the delay while playing,
+// or on a step key while paused.
+func (pl *player) wait() {
+	var timer <-chan time.Time
+	if !pl.paused {
+		timer = time.After(pl.delay)
+	}
+	for {
+		select {
+		case <-timer:
+			return
+		case <-pl.interrupt:
+			pl.quit = true
+			return
+		case k := <-pl.keys:
+			switch k {
+			case ' ':
+				pl.paused = !pl.paused
+				if !pl.paused {
+					return
+				}
+				timer = nil
+			case 'n', 'l':
+				if pl.paused {
+					return
+				}
+			case '+', '=':
+				pl.delay /= 2
+			case '-', '_':
+				pl.delay = max(2*pl.delay, time.Millisecond)
+			case 'q', 'Q':
+				pl.quit = true
+				return
+			}
+		}
+	}
+}
+
+// readKeys switches the terminal to unbuffered input without echo and
+// returns a channel of the keys pressed, or nil if stdin is not a terminal.
+func readKeys() <-chan byte {
+	if stty("-icanon", "-echo", "min", "1") != nil {
+		return nil
+	}
+	keys := make(chan byte)
+	go func() {
+		buf := make([]byte, 1)
+		for {
+			if _, err := os.Stdin.Read(buf); err != nil {
+				return
+			}
+			keys <- buf[0]
+		}
+	}()
+	return keys
+}
+
+// restoreTerminal undoes readKeys.
+func restoreTerminal() {
+	stty("icanon", "echo")
+}
+
+func stty(args ...string) error {
+	cmd := exec.Command("stty", args...)
+	cmd.Stdin = os.Stdin
+	return cmd.Run()
+}
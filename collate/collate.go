@@ -0,0 +1,154 @@
+// Package collate orders strings the way people expect to read them in a
+// list rather than by their bytes: ignoring case, ignoring accents, and
+// comparing embedded numbers by value, so that "file2" sorts before
+// "file10".
+//
+// A collation is a Key: a function mapping each string to a sort key whose
+// byte order is the order wanted. Keys plug into the sorting package in two
+// ways. The comparison sorts take k.Compare, or By for a string field of a
+// record, and compute keys as they compare. The radix sorts take k itself
+// (see sorting.RadixSortByKey) and compute every key once.
+//
+// The folding rules are not those of any locale: cases are folded with
+// unicode.ToLower, and accents are stripped from the Latin letters of
+// Latin-1 and Latin Extended-A and from decomposed text.
+package collate
+
+import (
+	"cmp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/RaviParvadiya/learn_dsa/comparator"
+)
+
+// Key maps a string to its sort key. Any function will do, so callers can
+// supply their own collation; New builds the common ones.
+type Key func(s string) string
+
+// Compare orders a and b by their keys.
+func (k Key) Compare(a, b string) int {
+	return cmp.Compare(k(a), k(b))
+}
+
+// Less reports whether a sorts before b under k.
+func (k Key) Less(a, b string) bool {
+	return k(a) < k(b)
+}
+
+// By orders values by the collation key of field(v).
+func By[T any](field func(T) string, k Key) comparator.Func[T] {
+	return func(a, b T) int {
+		return cmp.Compare(k(field(a)), k(field(b)))
+	}
+}
+
+// Mode selects the rules of a collation built by New.
+type Mode uint
+
+const (
+	// IgnoreCase treats upper and lower case letters as equal.
+	IgnoreCase Mode = 1 << iota
+
+	// IgnoreAccents treats accented Latin letters as their base letters
+	// ("é" as "e", "ß" as "ss") and ignores combining marks.
+	IgnoreAccents
+
+	// Numeric compares runs of ASCII digits by their value, so "a9" sorts
+	// before "a10". Leading zeros are ignored.
+	Numeric
+)
+
+// New returns the collation that applies the rules of mode. With no rules
+// it is the byte order of the strings themselves.
+func New(mode Mode) Key {
+	if mode == 0 {
+		return func(s string) string { return s }
+	}
+	return func(s string) string {
+		return string(appendKey(nil, s, mode))
+	}
+}
+
+// appendKey appends the key of s under mode to dst.
+func appendKey(dst []byte, s string, mode Mode) []byte {
+	for i := 0; i < len(s); {
+		if mode&Numeric != 0 && isDigit(s[i]) {
+			j := i
+			for j < len(s) && isDigit(s[j]) {
+				j++
+			}
+			dst = appendNumber(dst, s[i:j])
+			i = j
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+		if mode&IgnoreAccents != 0 {
+			if unicode.Is(unicode.Mn, r) {
+				continue
+			}
+			if base, ok := accentFold[r]; ok {
+				for _, b := range base {
+					dst = appendRune(dst, b, mode)
+				}
+				continue
+			}
+		}
+		dst = appendRune(dst, r, mode)
+	}
+	return dst
+}
+
+func appendRune(dst []byte, r rune, mode Mode) []byte {
+	if mode&IgnoreCase != 0 {
+		r = unicode.ToLower(r)
+	}
+	return utf8.AppendRune(dst, r)
+}
+
+// appendNumber appends the key of the run of digits: a '0', so that
+// numbers sort where digits would among other characters, then the number
+// of significant digits as four bytes, then the digits. A longer number is
+// a greater one, and numbers of the same length compare digit by digit.
+func appendNumber(dst []byte, digits string) []byte {
+	digits = strings.TrimLeft(digits, "0")
+	if digits == "" {
+		digits = "0"
+	}
+	n := uint32(len(digits))
+	dst = append(dst, '0', byte(n>>24), byte(n>>16), byte(n>>8), byte(n))
+	return append(dst, digits...)
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// accentGroups lists the accented letters that fold to each base.
+var accentGroups = []struct{ base, letters string }{
+	{"A", "ÀÁÂÃÄÅĀĂĄ"}, {"a", "àáâãäåāăą"}, {"AE", "Æ"}, {"ae", "æ"},
+	{"C", "ÇĆĈĊČ"}, {"c", "çćĉċč"}, {"D", "ÐĎĐ"}, {"d", "ðďđ"},
+	{"E", "ÈÉÊËĒĔĖĘĚ"}, {"e", "èéêëēĕėęě"}, {"G", "ĜĞĠĢ"}, {"g", "ĝğġģ"},
+	{"H", "ĤĦ"}, {"h", "ĥħ"}, {"I", "ÌÍÎÏĨĪĬĮİ"}, {"i", "ìíîïĩīĭįı"},
+	{"IJ", "Ĳ"}, {"ij", "ĳ"}, {"J", "Ĵ"}, {"j", "ĵ"}, {"K", "Ķ"}, {"k", "ķĸ"},
+	{"L", "ĹĻĽĿŁ"}, {"l", "ĺļľŀł"}, {"N", "ÑŃŅŇŊ"}, {"n", "ñńņňŉŋ"},
+	{"O", "ÒÓÔÕÖØŌŎŐ"}, {"o", "òóôõöøōŏő"}, {"OE", "Œ"}, {"oe", "œ"},
+	{"R", "ŔŖŘ"}, {"r", "ŕŗř"}, {"S", "ŚŜŞŠ"}, {"s", "śŝşšſ"}, {"ss", "ß"},
+	{"T", "ŢŤŦ"}, {"t", "ţťŧ"}, {"TH", "Þ"}, {"th", "þ"},
+	{"U", "ÙÚÛÜŨŪŬŮŰŲ"}, {"u", "ùúûüũūŭůűų"}, {"W", "Ŵ"}, {"w", "ŵ"},
+	{"Y", "ÝŶŸ"}, {"y", "ýÿŷ"}, {"Z", "ŹŻŽ"}, {"z", "źżž"},
+}
+
+// accentFold maps each letter of accentGroups to its base.
+var accentFold = func() map[rune]string {
+	m := make(map[rune]string)
+	for _, g := range accentGroups {
+		for _, r := range g.letters {
+			m[r] = g.base
+		}
+	}
+	return m
+}()
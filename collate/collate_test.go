@@ -0,0 +1,105 @@
+package collate_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/RaviParvadiya/learn_dsa/collate"
+	"github.com/RaviParvadiya/learn_dsa/sorting"
+)
+
+const all = collate.IgnoreCase | collate.IgnoreAccents | collate.Numeric
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		mode collate.Mode
+		a, b string
+		want int
+	}{
+		{0, "file2", "file10", 1},
+		{0, "B", "a", -1},
+		{collate.Numeric, "file2", "file10", -1},
+		{collate.Numeric, "a9", "a10", -1},
+		{collate.Numeric, "a10", "a9", 1},
+		{collate.Numeric, "a007", "a7", 0},
+		{collate.Numeric, "a0", "a000", 0},
+		{collate.Numeric, "a0", "a", 1},
+		{collate.Numeric, "a1b2", "a1b10", -1},
+		{collate.Numeric, "99999999999999999999", "100000000000000000000", -1},
+		{collate.Numeric, "1", "a", -1},
+		{collate.IgnoreCase, "B", "a", 1},
+		{collate.IgnoreCase, "ABC", "abc", 0},
+		{collate.IgnoreCase, "Σ", "σ", 0},
+		{collate.IgnoreCase, "é", "e", 1},
+		{collate.IgnoreAccents, "é", "e", 0},
+		{collate.IgnoreAccents, "e\u0301", "e", 0},
+		{collate.IgnoreAccents, "straße", "strasse", 0},
+		{collate.IgnoreAccents, "Æon", "AEon", 0},
+		{collate.IgnoreAccents, "É", "e", -1},
+		{collate.IgnoreAccents, "résumé", "resumf", -1},
+		{all, "Élan", "elan", 0},
+		{all, "File2", "file10", -1},
+		{all, "ÉTÉ 09", "ete 9", 0},
+	}
+	for _, tt := range tests {
+		k := collate.New(tt.mode)
+		if got := k.Compare(tt.a, tt.b); got != tt.want {
+			t.Errorf("mode %b: Compare(%q, %q) = %d, want %d", tt.mode, tt.a, tt.b, got, tt.want)
+		}
+		if got := k.Less(tt.a, tt.b); got != (tt.want < 0) {
+			t.Errorf("mode %b: Less(%q, %q) = %t", tt.mode, tt.a, tt.b, got)
+		}
+	}
+}
+
+// TestNumericKey pins down the key of a run of digits: '0', the count of
+// significant digits as four big-endian bytes, then the digits.
+func TestNumericKey(t *testing.T) {
+	k := collate.New(collate.Numeric)
+	tests := []struct{ s, want string }{
+		{"7", "0\x00\x00\x00\x017"},
+		{"a007b", "a0\x00\x00\x00\x017b"},
+		{"000", "0\x00\x00\x00\x010"},
+		{"x123", "x0\x00\x00\x00\x03123"},
+		{"1.25", "0\x00\x00\x00\x011.0\x00\x00\x00\x0225"},
+	}
+	for _, tt := range tests {
+		if got := k(tt.s); got != tt.want {
+			t.Errorf("key of %q = %q, want %q", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestRadixSortByKeyStable(t *testing.T) {
+	type file struct {
+		name string
+		pos  int
+	}
+	names := []string{"file10", "File2", "file2", "FILE02", "éte", "ete", "Ete", "file1", "file010", "ETE"}
+	var files []file
+	for i, n := range names {
+		files = append(files, file{n, i})
+	}
+
+	k := collate.New(all)
+	sorting.RadixSortByKey(files, func(f file) string { return k(f.name) })
+
+	var got []string
+	for _, f := range files {
+		got = append(got, f.name)
+	}
+	want := []string{"éte", "ete", "Ete", "ETE", "file1", "File2", "file2", "FILE02", "file10", "file010"}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestBy(t *testing.T) {
+	type person struct{ name string }
+	people := []person{{"Zoë"}, {"zoe"}, {"Adam"}, {"adam10"}, {"Adam9"}}
+	slices.SortStableFunc(people, collate.By(func(p person) string { return p.name }, collate.New(all)))
+	want := []person{{"Adam"}, {"Adam9"}, {"adam10"}, {"Zoë"}, {"zoe"}}
+	if !slices.Equal(people, want) {
+		t.Errorf("got %v, want %v", people, want)
+	}
+}
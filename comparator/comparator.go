@@ -0,0 +1,110 @@
+// Package comparator provides a single three-way ordering type shared by the
+// sorting and searching packages, with adapters to the less and equality
+// functions they also accept and combinators for building orderings from
+// smaller ones.
+package comparator
+
+import "cmp"
+
+// Func compares a and b, returning a negative number if a sorts before b,
+// zero if they are equivalent and a positive number if a sorts after b.
+type Func[T any] func(a, b T) int
+
+// Natural is the natural ascending order of T, as defined by cmp.Compare.
+func Natural[T cmp.Ordered]() Func[T] {
+	return cmp.Compare[T]
+}
+
+// FromLess builds a Func from a strict weak ordering less.
+func FromLess[T any](less func(a, b T) bool) Func[T] {
+	return func(a, b T) int {
+		if less(a, b) {
+			return -1
+		}
+		if less(b, a) {
+			return 1
+		}
+		return 0
+	}
+}
+
+// Less reports whether a sorts before b. The method value f.Less can be
+// passed wherever a less function is expected, such as the sorting XxxFunc
+// variants.
+func (f Func[T]) Less(a, b T) bool {
+	return f(a, b) < 0
+}
+
+// Equal reports whether a and b are equivalent under f. The method value
+// f.Equal can be used as an equality predicate.
+func (f Func[T]) Equal(a, b T) bool {
+	return f(a, b) == 0
+}
+
+// Reverse returns the opposite ordering of f.
+func Reverse[T any](f Func[T]) Func[T] {
+	return func(a, b T) int {
+		return f(b, a)
+	}
+}
+
+// ThenBy orders by first, breaking ties with each of then in turn.
+func ThenBy[T any](first Func[T], then ...Func[T]) Func[T] {
+	return func(a, b T) int {
+		if c := first(a, b); c != 0 {
+			return c
+		}
+		for _, f := range then {
+			if c := f(a, b); c != 0 {
+				return c
+			}
+		}
+		return 0
+	}
+}
+
+// ByKey orders values by the natural order of key(v).
+func ByKey[T any, K cmp.Ordered](key func(T) K) Func[T] {
+	return func(a, b T) int {
+		return cmp.Compare(key(a), key(b))
+	}
+}
+
+// ByKeyFunc orders values by comparing key(v) with f.
+func ByKeyFunc[T, K any](key func(T) K, f Func[K]) Func[T] {
+	return func(a, b T) int {
+		return f(key(a), key(b))
+	}
+}
+
+// NullsFirst orders pointers by f applied to their targets, with nil
+// pointers before all others.
+func NullsFirst[T any](f Func[T]) Func[*T] {
+	return func(a, b *T) int {
+		switch {
+		case a == nil && b == nil:
+			return 0
+		case a == nil:
+			return -1
+		case b == nil:
+			return 1
+		}
+		return f(*a, *b)
+	}
+}
+
+// NullsLast orders pointers by f applied to their targets, with nil
+// pointers after all others.
+func NullsLast[T any](f Func[T]) Func[*T] {
+	return func(a, b *T) int {
+		switch {
+		case a == nil && b == nil:
+			return 0
+		case a == nil:
+			return 1
+		case b == nil:
+			return -1
+		}
+		return f(*a, *b)
+	}
+}
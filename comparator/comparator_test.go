@@ -0,0 +1,155 @@
+package comparator_test
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/RaviParvadiya/learn_dsa/comparator"
+)
+
+// sign reduces a comparison result to -1, 0 or 1.
+func sign(c int) int {
+	return cmp.Compare(c, 0)
+}
+
+type pair[T any] struct {
+	a, b T
+	want int
+}
+
+// check fails t unless f orders each pair as wanted, and the Less and
+// Equal methods agree.
+func check[T any](t *testing.T, name string, f comparator.Func[T], pairs []pair[T]) {
+	t.Helper()
+	for _, p := range pairs {
+		if got := sign(f(p.a, p.b)); got != p.want {
+			t.Errorf("%s(%v, %v) = %d, want %d", name, p.a, p.b, got, p.want)
+		}
+		if got := f.Less(p.a, p.b); got != (p.want < 0) {
+			t.Errorf("%s.Less(%v, %v) = %t", name, p.a, p.b, got)
+		}
+		if got := f.Equal(p.a, p.b); got != (p.want == 0) {
+			t.Errorf("%s.Equal(%v, %v) = %t", name, p.a, p.b, got)
+		}
+	}
+}
+
+func TestNatural(t *testing.T) {
+	check(t, "Natural", comparator.Natural[int](), []pair[int]{
+		{1, 2, -1}, {2, 1, 1}, {3, 3, 0}, {math.MinInt, math.MaxInt, -1},
+	})
+	check(t, "Natural", comparator.Natural[float64](), []pair[float64]{
+		{math.NaN(), math.Inf(-1), -1}, {math.NaN(), math.NaN(), 0}, {math.Copysign(0, -1), 0, 0},
+	})
+}
+
+func TestFromLess(t *testing.T) {
+	byLen := comparator.FromLess(func(a, b string) bool { return len(a) < len(b) })
+	check(t, "FromLess", byLen, []pair[string]{
+		{"a", "bb", -1}, {"ccc", "bb", 1}, {"ab", "cd", 0}, {"", "", 0},
+	})
+}
+
+func TestReverse(t *testing.T) {
+	rev := comparator.Reverse(comparator.Natural[int]())
+	check(t, "Reverse", rev, []pair[int]{
+		{1, 2, 1}, {2, 1, -1}, {3, 3, 0}, {math.MinInt, math.MaxInt, 1},
+	})
+	check(t, "Reverse twice", comparator.Reverse(rev), []pair[int]{
+		{1, 2, -1}, {2, 1, 1}, {3, 3, 0},
+	})
+}
+
+type person struct {
+	name string
+	age  int
+	city string
+}
+
+func TestThenBy(t *testing.T) {
+	byAge := comparator.ByKey(func(p person) int { return p.age })
+	byName := comparator.ByKey(func(p person) string { return p.name })
+	byCity := comparator.ByKey(func(p person) string { return p.city })
+
+	check(t, "ThenBy no ties", comparator.ThenBy(byAge), []pair[person]{
+		{person{"b", 1, ""}, person{"a", 2, ""}, -1},
+		{person{"b", 1, ""}, person{"a", 1, ""}, 0},
+	})
+	f := comparator.ThenBy(byAge, byName, comparator.Reverse(byCity))
+	check(t, "ThenBy", f, []pair[person]{
+		// The first key decides whatever the others say.
+		{person{"z", 1, "z"}, person{"a", 2, "a"}, -1},
+		// A tie on age goes to the name.
+		{person{"a", 1, "a"}, person{"b", 1, "a"}, -1},
+		{person{"b", 1, "a"}, person{"a", 1, "z"}, 1},
+		// A tie on age and name goes to the city, reversed.
+		{person{"a", 1, "a"}, person{"a", 1, "b"}, 1},
+		{person{"a", 1, "b"}, person{"a", 1, "a"}, -1},
+		// A tie on every key is a tie.
+		{person{"a", 1, "b"}, person{"a", 1, "b"}, 0},
+	})
+
+	people := []person{{"b", 2, "x"}, {"a", 2, "x"}, {"a", 1, "y"}, {"a", 2, "z"}, {"c", 1, "x"}}
+	slices.SortFunc(people, f)
+	want := []person{{"a", 1, "y"}, {"c", 1, "x"}, {"a", 2, "z"}, {"a", 2, "x"}, {"b", 2, "x"}}
+	if !slices.Equal(people, want) {
+		t.Errorf("sorted by ThenBy: got %v, want %v", people, want)
+	}
+}
+
+func TestByKey(t *testing.T) {
+	check(t, "ByKey", comparator.ByKey(func(p person) int { return p.age }), []pair[person]{
+		{person{age: 30}, person{age: 40}, -1},
+		{person{age: 40}, person{age: 30}, 1},
+		{person{"a", 30, ""}, person{"b", 30, ""}, 0},
+	})
+	check(t, "ByKey", comparator.ByKey(strings.ToLower), []pair[string]{
+		{"B", "a", 1}, {"ABC", "abc", 0},
+	})
+}
+
+func TestByKeyFunc(t *testing.T) {
+	byNameLen := comparator.ByKeyFunc(func(p person) string { return p.name },
+		comparator.FromLess(func(a, b string) bool { return len(a) < len(b) }))
+	check(t, "ByKeyFunc", byNameLen, []pair[person]{
+		{person{name: "zz"}, person{name: "aaa"}, -1},
+		{person{name: "aaa"}, person{name: "zz"}, 1},
+		{person{name: "ab"}, person{name: "ba"}, 0},
+	})
+	desc := comparator.ByKeyFunc(func(p person) int { return p.age }, comparator.Reverse(comparator.Natural[int]()))
+	check(t, "ByKeyFunc reversed", desc, []pair[person]{
+		{person{age: 30}, person{age: 40}, 1},
+	})
+}
+
+func TestNulls(t *testing.T) {
+	one, two, otherOne := 1, 2, 1
+	first := comparator.NullsFirst(comparator.Natural[int]())
+	last := comparator.NullsLast(comparator.Natural[int]())
+
+	check(t, "NullsFirst", first, []pair[*int]{
+		{nil, nil, 0},
+		{nil, &one, -1},
+		{&one, nil, 1},
+		{&one, &two, -1},
+		{&two, &one, 1},
+		{&one, &otherOne, 0},
+	})
+	check(t, "NullsLast", last, []pair[*int]{
+		{nil, nil, 0},
+		{nil, &one, 1},
+		{&one, nil, -1},
+		{&one, &two, -1},
+		{&two, &one, 1},
+		{&one, &otherOne, 0},
+	})
+
+	ptrs := []*int{&two, nil, &one, nil}
+	slices.SortStableFunc(ptrs, last)
+	if *ptrs[0] != 1 || *ptrs[1] != 2 || ptrs[2] != nil || ptrs[3] != nil {
+		t.Errorf("sorted by NullsLast: %v", ptrs)
+	}
+}
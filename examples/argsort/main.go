@@ -0,0 +1,57 @@
+// Command argsort demonstrates sorting by index permutations: sorting
+// parallel columns by one of them, and ranking, without moving records.
+package main
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/RaviParvadiya/learn_dsa/sorting"
+)
+
+func main() {
+	// Three parallel columns of one table
+	names := []string{"Carol", "Alice", "Bob", "Dave", "Erin"}
+	ages := []int{41, 30, 41, 30, 25}
+	scores := []float64{88.5, 92, 75.25, 92, 60}
+
+	// 1. Argsorts leave their input alone
+	fmt.Println("Ages:", ages)
+	fmt.Println("1. Merge argsort:   ", sorting.ArgsortMerge(ages))
+	fmt.Println("   Quick argsort:   ", sorting.ArgsortQuick(ages))
+	fmt.Println("   Counting argsort:", sorting.ArgsortCounting(ages))
+
+	// 2. Read the table in score order without moving anything
+	byScore := sorting.ArgsortMergeFunc(scores, func(a, b float64) bool { return a > b })
+	fmt.Println("\n2. By score desc:")
+	for _, i := range byScore {
+		fmt.Printf("   %-6s %d %6.2f\n", names[i], ages[i], scores[i])
+	}
+
+	// 3. Rank of each row, the inverse of the sorting permutation
+	fmt.Println("\n3. Score ranks:", sorting.InvertPermutation(byScore))
+
+	// 4. Sort every column by age, then restore the original order
+	byAge := sorting.ArgsortCounting(ages)
+	sorting.ApplyPermutation(names, byAge)
+	sorting.ApplyPermutation(ages, byAge)
+	sorting.ApplyPermutation(scores, byAge)
+	fmt.Println("\n4. Columns sorted by age:", names, ages, scores)
+
+	undo := sorting.InvertPermutation(byAge)
+	sorting.ApplyPermutation(names, undo)
+	sorting.ApplyPermutation(ages, undo)
+	sorting.ApplyPermutation(scores, undo)
+	fmt.Println("   Restored:             ", names, ages, scores)
+
+	// 5. Age asc, then name asc: stably sort by name, then stably sort the
+	// ages in that order, and compose the two permutations
+	byName := sorting.ArgsortMerge(names)
+	agesByName := slices.Clone(ages)
+	sorting.ApplyPermutation(agesByName, byName)
+	both := sorting.ComposePermutations(byName, sorting.ArgsortCounting(agesByName))
+	fmt.Println("\n5. By age asc, name asc:")
+	for _, i := range both {
+		fmt.Printf("   %-6s %d\n", names[i], ages[i])
+	}
+}
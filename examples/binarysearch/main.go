@@ -0,0 +1,103 @@
+// Command binarysearch demonstrates the binary search variants.
+package main
+
+import (
+	"fmt"
+
+	"github.com/RaviParvadiya/learn_dsa/records"
+	"github.com/RaviParvadiya/learn_dsa/searching"
+)
+
+func main() {
+	arr := []int{1, 3, 5, 7, 9, 11, 13, 15, 17, 19}
+	target := 13
+
+	fmt.Println("Sorted array:", arr)
+	fmt.Println("Target:", target)
+	fmt.Println()
+
+	// 1. Iterative
+	fmt.Println("1. Iterative Binary Search:", searching.BinarySearchIterative(arr, target))
+
+	// 2. Recursive
+	fmt.Println("2. Recursive Binary Search:", searching.BinarySearchRecursive(arr, target, 0, len(arr)-1))
+
+	// 3-5. Duplicates
+	arrDup := []int{1, 2, 2, 2, 3, 4, 5, 5, 5, 6}
+	fmt.Println("\nArray with duplicates:", arrDup)
+	fmt.Println("3. First Occurrence of 2:", searching.BinarySearchFirst(arrDup, 2))
+	fmt.Println("4. Last Occurrence of 2:", searching.BinarySearchLast(arrDup, 2))
+	fmt.Println("5. Count of 5:", searching.BinarySearchCount(arrDup, 5))
+
+	// 6-7. Bounds
+	fmt.Println("\n6. Lower Bound of 8:", searching.BinarySearchLowerBound(arr, 8))
+	fmt.Println("7. Upper Bound of 8:", searching.BinarySearchUpperBound(arr, 8))
+
+	// 8. Infinite array
+	fmt.Println("\n8. Binary Search Infinite Array:", searching.BinarySearchInfinite(arr, 15))
+
+	// 9. Rotated array
+	rotated := []int{15, 17, 19, 1, 3, 5, 7, 9, 11, 13}
+	fmt.Println("\n9. Rotated Array:", rotated)
+	fmt.Println("   Search 5:", searching.BinarySearchRotated(rotated, 5))
+
+	// 10. Peak element
+	peakArr := []int{1, 3, 20, 4, 1, 0}
+	fmt.Println("\n10. Peak Element in:", peakArr)
+	fmt.Println("    Peak at index:", searching.BinarySearchPeak(peakArr))
+
+	// 11. Square root
+	fmt.Println("\n11. Square Root of 25:", searching.BinarySearchSqrt(25))
+	fmt.Println("    Square Root of 27:", searching.BinarySearchSqrt(27))
+
+	// 12. Ternary search
+	fmt.Println("\n12. Ternary Search for 13:", searching.TernarySearch(arr, 13, 0, len(arr)-1))
+
+	// 13. Exponential search
+	fmt.Println("\n13. Exponential Search for 15:", searching.ExponentialSearch(arr, 15))
+
+	// 14. Interpolation search
+	uniform := []int{10, 20, 30, 40, 50, 60, 70, 80, 90}
+	fmt.Println("\n14. Interpolation Search in:", uniform)
+	fmt.Println("    Search 50:", searching.InterpolationSearch(uniform, 50))
+
+	// 15. 2D Matrix
+	matrix := [][]int{
+		{1, 4, 7, 11},
+		{2, 5, 8, 12},
+		{3, 6, 9, 16},
+		{10, 13, 14, 17},
+	}
+	fmt.Println("\n15. 2D Matrix Search for 5:", searching.BinarySearch2D(matrix, 5))
+
+	// 16. Closest element
+	fmt.Println("\n16. Closest to 8:", searching.BinarySearchClosest(arr, 8))
+	fmt.Println("    Closest to 10:", searching.BinarySearchClosest(arr, 10))
+
+	// 17. Custom comparator
+	fmt.Println("\n17. Custom Comparator:",
+		searching.BinarySearchCustom(arr, 13, func(a, b int) int {
+			if a < b {
+				return -1
+			}
+			if a > b {
+				return 1
+			}
+			return 0
+		}))
+
+	// 18. Objects
+	people := []records.Person{
+		{Name: "Alice", Age: 20},
+		{Name: "Bob", Age: 25},
+		{Name: "Charlie", Age: 30},
+		{Name: "David", Age: 35},
+	}
+	fmt.Println("\n18. Binary Search Objects (age 30):", searching.BinarySearchObjects(people, 30))
+
+	// 19. Key extractor
+	byName := func(p records.Person) string { return p.Name }
+	fmt.Println("\n19. Lower Bound by Name (\"Bz\"):", searching.BinarySearchLowerBoundBy(people, "Bz", byName))
+	words := []string{"apple", "banana", "cherry", "date"}
+	fmt.Println("    Strings (cherry):", searching.BinarySearchIterative(words, "cherry"))
+}
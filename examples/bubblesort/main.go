@@ -0,0 +1,87 @@
+// Command bubblesort demonstrates the bubble sort variants.
+package main
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/RaviParvadiya/learn_dsa/comparator"
+	"github.com/RaviParvadiya/learn_dsa/lists"
+	"github.com/RaviParvadiya/learn_dsa/records"
+	"github.com/RaviParvadiya/learn_dsa/sorting"
+)
+
+func main() {
+	original := []int{64, 34, 25, 12, 22, 11, 90}
+
+	fmt.Println("Original array:", original)
+	fmt.Println()
+
+	// 1. Basic
+	arr1 := slices.Clone(original)
+	sorting.BubbleSortBasic(arr1)
+	fmt.Println("1. Basic Bubble Sort:", arr1)
+
+	// 2. Optimized
+	arr2 := slices.Clone(original)
+	sorting.BubbleSortOptimized(arr2)
+	fmt.Println("2. Optimized Bubble Sort:", arr2)
+
+	// 3. Recursive
+	arr3 := slices.Clone(original)
+	sorting.BubbleSortRecursive(arr3, len(arr3))
+	fmt.Println("3. Recursive Bubble Sort:", arr3)
+
+	// 4. Cocktail Shaker
+	arr4 := slices.Clone(original)
+	sorting.BubbleSortCocktail(arr4)
+	fmt.Println("4. Cocktail Shaker Sort:", arr4)
+
+	// 5. Odd-Even
+	arr5 := slices.Clone(original)
+	sorting.BubbleSortOddEven(arr5)
+	fmt.Println("5. Odd-Even Sort:", arr5)
+
+	// 6. Comb Sort
+	arr6 := slices.Clone(original)
+	sorting.BubbleSortComb(arr6)
+	fmt.Println("6. Comb Sort:", arr6)
+
+	// 7. Descending
+	arr7 := slices.Clone(original)
+	sorting.BubbleSortDescending(arr7)
+	fmt.Println("7. Bubble Sort (descending):", arr7)
+
+	// 8. Linked List
+	head := lists.FromSlice([]int{64, 34, 25, 12, 22})
+	head = lists.BubbleSort(head)
+	fmt.Println("8. Linked List Bubble Sort:", head)
+
+	// 9. Custom Comparator (descending)
+	arr9 := slices.Clone(original)
+	sorting.BubbleSortCustom(arr9, comparator.Reverse(comparator.Natural[int]()))
+	fmt.Println("9. Custom Comparator (desc):", arr9)
+
+	// 10. Objects
+	students := []records.Student{
+		{Name: "Alice", Score: 85},
+		{Name: "Bob", Score: 92},
+		{Name: "Charlie", Score: 78},
+		{Name: "David", Score: 95},
+	}
+	fmt.Println("\n10. Bubble Sort for Objects:")
+	fmt.Println("    Original:", students)
+	sorting.BubbleSortObjects(students)
+	fmt.Println("    Sorted by Score:", students)
+
+	// 11. Last Swap Optimization
+	arr11 := slices.Clone(original)
+	sorting.BubbleSortLastSwap(arr11)
+	fmt.Println("\n11. Last Swap Optimization:", arr11)
+
+	// 12. With Count
+	arr12 := slices.Clone(original)
+	comp, swaps := sorting.BubbleSortWithCount(arr12)
+	fmt.Printf("\n12. With Statistics: %v\n", arr12)
+	fmt.Printf("    Comparisons: %d, Swaps: %d\n", comp, swaps)
+}
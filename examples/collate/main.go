@@ -0,0 +1,47 @@
+// Command collate demonstrates sorting names in reading order with the
+// collations of package collate.
+package main
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/RaviParvadiya/learn_dsa/collate"
+	"github.com/RaviParvadiya/learn_dsa/records"
+	"github.com/RaviParvadiya/learn_dsa/sorting"
+)
+
+func main() {
+	files := []string{"file10.txt", "File2.txt", "file1.txt", "file02.txt", "file20.txt"}
+	fmt.Println("Original:", files)
+
+	// 1. Byte order puts capitals first and "10" before "2"
+	bytes := slices.Clone(files)
+	sorting.RadixSortStringsMSD(bytes)
+	fmt.Println("1. Byte order:", bytes)
+
+	// 2. Natural order, ignoring case
+	natural := collate.New(collate.IgnoreCase | collate.Numeric)
+	sorted := slices.Clone(files)
+	sorting.RadixSortByKey(sorted, natural)
+	fmt.Println("2. Natural order:", sorted)
+
+	// 3. Comparison sorts take the collation's Compare
+	insertion := slices.Clone(files)
+	sorting.InsertionSortBasicFunc(insertion, natural.Less)
+	fmt.Println("3. Insertion sort, natural order:", insertion)
+
+	// 4. Records by a collated field
+	people := []records.Person{
+		{Name: "Zoë", Age: 31},
+		{Name: "émile", Age: 28},
+		{Name: "Eve", Age: 40},
+		{Name: "zack", Age: 22},
+		{Name: "Åsa", Age: 35},
+	}
+	byName := collate.By(func(p records.Person) string { return p.Name }, collate.New(collate.IgnoreCase|collate.IgnoreAccents))
+	fmt.Println("\n4. People by raw name:", sorting.MergeSortBasicFunc(slices.Clone(people), func(a, b records.Person) bool {
+		return a.Name < b.Name
+	}))
+	fmt.Println("   People by collated name:", sorting.MergeSortCustom(slices.Clone(people), byName))
+}
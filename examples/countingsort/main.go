@@ -0,0 +1,64 @@
+// Command countingsort demonstrates the counting sort variants.
+package main
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/RaviParvadiya/learn_dsa/records"
+	"github.com/RaviParvadiya/learn_dsa/sorting"
+)
+
+func main() {
+	// Test basic counting sort
+	arr1 := []int{4, 2, 2, 8, 3, 3, 1}
+	fmt.Println("Original array:", arr1)
+	fmt.Println("1. Basic Counting Sort:", sorting.CountingSortBasic(slices.Clone(arr1)))
+
+	// Test in-place
+	arr2 := slices.Clone(arr1)
+	sorting.CountingSortInPlace(arr2)
+	fmt.Println("2. In-Place Counting Sort:", arr2)
+
+	// Test stable
+	arr3 := slices.Clone(arr1)
+	fmt.Println("3. Stable Counting Sort:", sorting.CountingSortStable(arr3))
+
+	// Test with negatives
+	arr4 := []int{-5, -10, 0, -3, 8, 5, -1, 10}
+	fmt.Println("\n4. With Negatives:", arr4)
+	fmt.Println("   Sorted:", sorting.CountingSortWithNegatives(arr4))
+
+	// Test limited range
+	arr5 := []int{5, 7, 6, 8, 5, 9, 7}
+	fmt.Println("\n5. Limited Range (5-9):", arr5)
+	fmt.Println("   Sorted:", sorting.CountingSortLimitedRange(arr5, 5, 9))
+
+	// Test with objects
+	people := []records.Person{
+		{Name: "Alice", Age: 25},
+		{Name: "Bob", Age: 30},
+		{Name: "Charlie", Age: 25},
+		{Name: "David", Age: 20},
+		{Name: "Eve", Age: 30},
+	}
+	fmt.Println("\n6. Sorting Objects by Age:")
+	fmt.Println("   Original:", people)
+	fmt.Println("   Sorted:", sorting.CountingSortObjects(people))
+
+	// Test optimized
+	arr7 := []int{100, 105, 102, 108, 103}
+	fmt.Println("\n7. Optimized (dynamic range):", arr7)
+	fmt.Println("   Sorted:", sorting.CountingSortOptimized(arr7))
+
+	// Test radix sort helper
+	arr8 := []int{170, 45, 75, 90, 802, 24, 2, 66}
+	fmt.Println("\n8. Radix Sort (using counting sort):", arr8)
+	// Find max for number of digits
+	max := slices.Max(arr8)
+	// Apply counting sort for each digit
+	for exp := 1; max/exp > 0; exp *= 10 {
+		sorting.CountingSortRadix(arr8, exp)
+	}
+	fmt.Println("   Sorted:", arr8)
+}
@@ -0,0 +1,77 @@
+// Command insertionsort demonstrates the insertion sort variants.
+package main
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/RaviParvadiya/learn_dsa/lists"
+	"github.com/RaviParvadiya/learn_dsa/records"
+	"github.com/RaviParvadiya/learn_dsa/sorting"
+)
+
+func main() {
+	original := []int{64, 34, 25, 12, 22, 11, 90, 88}
+
+	fmt.Println("Original array:", original)
+	fmt.Println()
+
+	// 1. Basic (with shifting)
+	arr1 := slices.Clone(original)
+	sorting.InsertionSortBasic(arr1)
+	fmt.Println("1. Basic Insertion Sort (shifting):", arr1)
+
+	// 2. Swapping
+	arr2 := slices.Clone(original)
+	sorting.InsertionSortSwapping(arr2)
+	fmt.Println("2. Insertion Sort (swapping):", arr2)
+
+	// 3. Binary Insertion Sort
+	arr3 := slices.Clone(original)
+	sorting.InsertionSortBinary(arr3)
+	fmt.Println("3. Binary Insertion Sort:", arr3)
+
+	// 4. Recursive
+	arr4 := slices.Clone(original)
+	sorting.InsertionSortRecursive(arr4, len(arr4))
+	fmt.Println("4. Recursive Insertion Sort:", arr4)
+
+	// 5. Sentinel
+	arr5 := slices.Clone(original)
+	sorting.InsertionSortSentinel(arr5)
+	fmt.Println("5. Sentinel Insertion Sort:", arr5)
+
+	// 6. With Gap (gap = 3)
+	arr6 := slices.Clone(original)
+	sorting.InsertionSortWithGap(arr6, 3)
+	sorting.InsertionSortWithGap(arr6, 1) // Final pass with gap=1
+	fmt.Println("6. Insertion Sort with Gap:", arr6)
+
+	// 7. Bidirectional
+	arr7 := slices.Clone(original)
+	sorting.InsertionSortBidirectional(arr7)
+	fmt.Println("7. Bidirectional Insertion Sort:", arr7)
+
+	// 8. Linked List
+	head := lists.FromSlice([]int{64, 34, 25, 12, 22})
+	head = lists.InsertionSort(head)
+	fmt.Println("8. Linked List Insertion Sort:", head)
+
+	// 9. Early Termination
+	arr9 := slices.Clone(original)
+	sorting.InsertionSortEarlyTermination(arr9)
+	fmt.Println("9. Early Termination Insertion Sort:", arr9)
+
+	// 10. Objects
+	students := []records.Student{
+		{Name: "Alice", Score: 85},
+		{Name: "Bob", Score: 92},
+		{Name: "Charlie", Score: 78},
+		{Name: "David", Score: 92},
+		{Name: "Eve", Score: 88},
+	}
+	fmt.Println("\n10. Insertion Sort for Objects:")
+	fmt.Println("    Original:", students)
+	sorting.InsertionSortObjects(students)
+	fmt.Println("    Sorted:", students)
+}
@@ -0,0 +1,49 @@
+// Command keyenc demonstrates radix sorting records on several fields at
+// once through composite keys encoded by package keyenc.
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/RaviParvadiya/learn_dsa/keyenc"
+	"github.com/RaviParvadiya/learn_dsa/sorting"
+)
+
+type employee struct {
+	Name   string
+	Age    int
+	Salary float64
+	Remote bool
+	Joined time.Time
+}
+
+func main() {
+	date := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+	staff := []employee{
+		{"Carol", 41, 98000, true, date(2015, 3, 1)},
+		{"Alice", 30, 72000, false, date(2019, 6, 15)},
+		{"Bob", 41, 98000, false, date(2012, 1, 9)},
+		{"Dave", 30, 65000.5, true, date(2019, 6, 15)},
+		{"Erin", 25, 72000, true, date(2021, 11, 2)},
+	}
+
+	// 1. Age descending, then name ascending
+	sorting.RadixSortByKey(staff, func(e employee) string {
+		return keyenc.New().Int(int64(e.Age), keyenc.Desc).String(e.Name, keyenc.Asc).Key()
+	})
+	fmt.Println("1. By age desc, name asc:")
+	for _, e := range staff {
+		fmt.Printf("   %-6s %d\n", e.Name, e.Age)
+	}
+
+	// 2. Salary descending, remote workers first, then earliest joined
+	enc := keyenc.New()
+	sorting.RadixSortByKey(staff, func(e employee) string {
+		return enc.Reset().Float(e.Salary, keyenc.Desc).Bool(e.Remote, keyenc.Desc).Time(e.Joined, keyenc.Asc).Key()
+	})
+	fmt.Println("\n2. By salary desc, remote first, joined asc:")
+	for _, e := range staff {
+		fmt.Printf("   %-6s %9.1f remote=%-5t joined %s\n", e.Name, e.Salary, e.Remote, e.Joined.Format(time.DateOnly))
+	}
+}
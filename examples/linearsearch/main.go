@@ -0,0 +1,94 @@
+// Command linearsearch demonstrates the linear search variants.
+package main
+
+import (
+	"fmt"
+
+	"github.com/RaviParvadiya/learn_dsa/records"
+	"github.com/RaviParvadiya/learn_dsa/searching"
+)
+
+func main() {
+	arr := []int{10, 23, 45, 70, 11, 15, 23, 45}
+	target := 23
+
+	fmt.Println("Array:", arr)
+	fmt.Println("Target:", target)
+	fmt.Println()
+
+	// 1. Basic
+	fmt.Println("1. Basic Linear Search:", searching.LinearSearchBasic(arr, target))
+
+	// 2. Range-based
+	fmt.Println("2. Range-based Linear Search:", searching.LinearSearchRange(arr, target))
+
+	// 3. Boolean return
+	fmt.Println("3. Boolean Linear Search:", searching.LinearSearchBool(arr, target))
+
+	// 4. Reverse
+	fmt.Println("4. Reverse Linear Search:", searching.LinearSearchReverse(arr, target))
+
+	// 5. Recursive
+	fmt.Println("5. Recursive Linear Search:", searching.LinearSearchRecursive(arr, target, 0))
+
+	// 6. Find all
+	fmt.Println("6. Find All Occurrences:", searching.LinearSearchAll(arr, target))
+
+	// 7. Count
+	fmt.Println("7. Count Occurrences:", searching.LinearSearchCount(arr, target))
+
+	// 8. Sentinel
+	arr8 := []int{10, 23, 45, 70, 11, 15}
+	fmt.Println("\n8. Sentinel Linear Search:", searching.LinearSearchSentinel(arr8, target))
+
+	// 9. Bidirectional
+	fmt.Println("9. Bidirectional Linear Search:", searching.LinearSearchBidirectional(arr, target))
+
+	// 10. Sorted with early termination
+	sortedArr := []int{5, 10, 15, 20, 25, 30}
+	fmt.Println("\n10. Sorted Linear Search (early term):", searching.LinearSearchSorted(sortedArr, 15))
+	fmt.Println("    Searching for 22 (doesn't exist):", searching.LinearSearchSorted(sortedArr, 22))
+
+	// 11. Custom comparator
+	fmt.Println("\n11. Custom Comparator (equals):",
+		searching.LinearSearchCustom(arr, target, func(a, b int) bool { return a == b }))
+
+	// 12. Objects
+	people := []records.Person{
+		{Name: "Alice", Age: 25},
+		{Name: "Bob", Age: 30},
+		{Name: "Charlie", Age: 25},
+	}
+	fmt.Println("\n12. Linear Search Objects (age 25):", searching.LinearSearchObjects(people, 25))
+
+	// 13. Predicate
+	fmt.Println("\n13. Predicate (find even number):",
+		searching.LinearSearchPredicate(arr, func(x int) bool { return x%2 == 0 }))
+
+	// 14. Transposition
+	arr14 := []int{10, 23, 45, 70, 11, 15}
+	fmt.Println("\n14. Transposition Search:")
+	fmt.Println("    Before:", arr14)
+	idx := searching.LinearSearchTransposition(arr14, 70)
+	fmt.Println("    Found at:", idx)
+	fmt.Println("    After:", arr14)
+
+	// 15. Move-to-Front
+	arr15 := []int{10, 23, 45, 70, 11, 15}
+	fmt.Println("\n15. Move-to-Front Search:")
+	fmt.Println("    Before:", arr15)
+	idx2 := searching.LinearSearchMoveToFront(arr15, 70)
+	fmt.Println("    Found at:", idx2)
+	fmt.Println("    After:", arr15)
+
+	// 16. Frequency Count
+	arr16 := []searching.FreqElement[int]{
+		{Value: 10}, {Value: 23}, {Value: 45}, {Value: 70},
+	}
+	fmt.Println("\n16. Frequency Count Search:")
+	fmt.Println("    Initial:", arr16)
+	searching.LinearSearchFrequency(arr16, 70)
+	fmt.Println("    After 1 search:", arr16)
+	searching.LinearSearchFrequency(arr16, 70)
+	fmt.Println("    After 2 searches:", arr16)
+}
@@ -0,0 +1,116 @@
+// Command mergesort demonstrates the merge sort variants.
+package main
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/RaviParvadiya/learn_dsa/comparator"
+	"github.com/RaviParvadiya/learn_dsa/lists"
+	"github.com/RaviParvadiya/learn_dsa/records"
+	"github.com/RaviParvadiya/learn_dsa/sorting"
+)
+
+func main() {
+	arrA := []int{33, 45, 40, 25, 17, 24}
+	sorting.MergeSort1(arrA, 0, len(arrA)-1)
+	fmt.Println("MergeSort1:", arrA)
+
+	arrB := []int{33, 45, 40, 25, 17, 24}
+	sorting.MergeSort2(arrB, 0, len(arrB)-1)
+	fmt.Println("MergeSort2:", arrB)
+	fmt.Println()
+
+	original := []int{38, 27, 43, 3, 9, 82, 10}
+
+	fmt.Println("Original array:", original)
+	fmt.Println()
+
+	// 1. Basic Recursive
+	arr1 := slices.Clone(original)
+	result1 := sorting.MergeSortBasic(arr1)
+	fmt.Println("1. Basic Recursive Merge Sort:", result1)
+
+	// 2. In-Place
+	arr2 := slices.Clone(original)
+	sorting.MergeSortInPlace(arr2, 0, len(arr2)-1)
+	fmt.Println("2. In-Place Merge Sort:", arr2)
+
+	// 3. Iterative (Bottom-Up)
+	arr3 := slices.Clone(original)
+	sorting.MergeSortIterative(arr3)
+	fmt.Println("3. Iterative Merge Sort:", arr3)
+
+	// 4. Three-Way
+	arr4 := slices.Clone(original)
+	result4 := sorting.MergeSortThreeWay(arr4)
+	fmt.Println("4. Three-Way Merge Sort:", result4)
+
+	// 5. Hybrid
+	arr5 := slices.Clone(original)
+	sorting.MergeSortHybrid(arr5, 0, len(arr5)-1)
+	fmt.Println("5. Hybrid Merge Sort:", arr5)
+
+	// 6. Natural
+	arr6 := []int{3, 9, 27, 38, 10, 43, 82} // Has some natural runs
+	fmt.Println("\n6. Natural Merge Sort:")
+	fmt.Println("   Original:", arr6)
+	sorting.MergeSortNatural(arr6)
+	fmt.Println("   Sorted:", arr6)
+
+	// 7. Parallel
+	arr7 := slices.Clone(original)
+	sorting.MergeSortParallel(arr7, 0) // one worker per GOMAXPROCS
+	fmt.Println("\n7. Parallel Merge Sort:", arr7)
+
+	// 8. Linked List
+	head := lists.FromSlice([]int{38, 27, 43, 3, 9})
+	head = lists.MergeSort(head)
+	fmt.Println("\n8. Linked List Merge Sort:", head)
+
+	// 9. Custom Comparator (descending)
+	arr9 := slices.Clone(original)
+	result9 := sorting.MergeSortCustom(arr9, comparator.Reverse(comparator.Natural[int]()))
+	fmt.Println("\n9. Custom Comparator (desc):", result9)
+
+	// 10. Objects
+	people := []records.Person{
+		{Name: "Alice", Age: 30},
+		{Name: "Bob", Age: 25},
+		{Name: "Charlie", Age: 35},
+		{Name: "David", Age: 20},
+	}
+	fmt.Println("\n10. Merge Sort for Objects:")
+	fmt.Println("    Original:", people)
+	sorted := sorting.MergeSortObjects(people)
+	fmt.Println("    Sorted by Age:", sorted)
+
+	// 11. Generic element types
+	words := []string{"pear", "apple", "fig", "banana"}
+	fmt.Println("\n11. Generic Merge Sort:")
+	fmt.Println("    Strings:", sorting.MergeSortBasic(words))
+	prices := []float64{9.99, 0.5, 3.25, 1.75}
+	sorting.MergeSortIterative(prices)
+	fmt.Println("    Floats:", prices)
+	byName := sorting.MergeSortBasicFunc(slices.Clone(people), func(a, b records.Person) bool {
+		return a.Name < b.Name
+	})
+	fmt.Println("    People by Name:", byName)
+
+	// 12. Composed comparator (oldest first, then by name)
+	team := []records.Person{
+		{Name: "Erin", Age: 30},
+		{Name: "Bob", Age: 25},
+		{Name: "Alice", Age: 30},
+	}
+	byAgeDescThenName := comparator.ThenBy(
+		comparator.Reverse(comparator.ByKey(func(p records.Person) int { return p.Age })),
+		comparator.ByKey(func(p records.Person) string { return p.Name }),
+	)
+	fmt.Println("\n12. Composed Comparator:", sorting.MergeSortCustom(team, byAgeDescThenName))
+
+	// 13. Block merge: stable without extra memory
+	arr13 := slices.Clone(original)
+	sorting.BlockMergeSort(arr13)
+	fmt.Println("\n13. Block Merge Sort:", arr13)
+}
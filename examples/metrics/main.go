@@ -0,0 +1,47 @@
+// Command metrics compares the operation counts of every registered sorting
+// variant on the same random input.
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"slices"
+	"text/tabwriter"
+
+	"github.com/RaviParvadiya/learn_dsa/instrument"
+	"github.com/RaviParvadiya/learn_dsa/sorting"
+)
+
+func main() {
+	const n = 1000
+
+	rng := rand.New(rand.NewSource(1))
+	original := make([]int, n)
+	for i := range original {
+		original[i] = rng.Intn(n)
+	}
+
+	fmt.Printf("Operation counts for %d random values in [0, %d):\n\n", n, n)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "Algorithm\tComparisons\tSwaps\tWrites\tAllocs\tAlloc elems\tMax depth\t")
+	for _, a := range sorting.Algorithms() {
+		arr := slices.Clone(original)
+		var p instrument.Probe
+		a.Sort(arr, &p)
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t%d\t%d\t\n",
+			a.Name, p.Comparisons, p.Swaps, p.Writes, p.Allocs, p.AllocElems, p.MaxDepth)
+	}
+	w.Flush()
+
+	// Partition schemes side by side
+	fmt.Println("\nPartition schemes:")
+	for _, name := range []string{"QuicksortLomuto", "QuicksortHoare", "QuicksortDualPivot"} {
+		a, _ := sorting.Lookup(name)
+		arr := slices.Clone(original)
+		var p instrument.Probe
+		a.Sort(arr, &p)
+		fmt.Printf("  %-20s %6d comparisons, %5d swaps\n", name+":", p.Comparisons, p.Swaps)
+	}
+}
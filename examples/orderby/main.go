@@ -0,0 +1,64 @@
+// Command orderby demonstrates sorting records on several fields from an
+// ordering spec such as "age desc, name asc" with package orderby.
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/RaviParvadiya/learn_dsa/collate"
+	"github.com/RaviParvadiya/learn_dsa/orderby"
+)
+
+type employee struct {
+	Name   string  `sort:"name"`
+	Dept   string  `sort:"dept"`
+	Age    int     `sort:"age"`
+	Salary float64 `sort:"salary"`
+	Remote bool    `sort:"remote"`
+	Notes  string  `sort:"-"`
+}
+
+func main() {
+	staff := []employee{
+		{"carol", "Ops", 41, 98000, true, ""},
+		{"Alice", "Dev", 30, 72000, false, ""},
+		{"Bob", "Ops", 41, 98000, false, "on leave"},
+		{"Dave", "Dev", 30, 65000.5, true, ""},
+		{"Erin", "Dev", 25, 72000, true, ""},
+	}
+
+	// 1. Fields declared from struct tags
+	fields, err := orderby.Tags[employee]()
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, spec := range []string{"age desc, name", "dept, salary desc, remote desc"} {
+		if err := fields.Sort(staff, spec); err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("1. Sorted by %q:\n", spec)
+		show(staff)
+	}
+
+	// 2. Fields declared one by one, with a case-insensitive name
+	fields2 := orderby.NewFields[employee]().
+		Int("age", func(e employee) int { return e.Age }).
+		Func("name", collate.By(func(e employee) string { return e.Name }, collate.New(collate.IgnoreCase)))
+	if err := fields2.SortKeys(staff, orderby.Asc("age"), orderby.Asc("name")); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println("2. Sorted by age asc, name asc ignoring case:")
+	show(staff)
+
+	// 3. Bad specs are reported, not ignored
+	for _, spec := range []string{"age up", "notes"} {
+		fmt.Printf("3. Sorting by %q: %v\n", spec, fields.Sort(staff, spec))
+	}
+}
+
+func show(staff []employee) {
+	for _, e := range staff {
+		fmt.Printf("   %-6s %-4s %d %9.1f remote=%t\n", e.Name, e.Dept, e.Age, e.Salary, e.Remote)
+	}
+}
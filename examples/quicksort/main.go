@@ -0,0 +1,72 @@
+// Command quicksort demonstrates the quicksort variants.
+package main
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/RaviParvadiya/learn_dsa/sorting"
+)
+
+func main() {
+	data := []int{9, 1, 8, 2, 7, 3, 6, 4, 5}
+	sorting.QuickSort(data)
+	fmt.Println("QuickSort:", data)
+	fmt.Println()
+
+	original := []int{64, 34, 25, 12, 22, 11, 90, 88, 45, 50, 23, 36}
+
+	// Test each variant
+	fmt.Println("Original array:", original)
+	fmt.Println()
+
+	// 1. Lomuto
+	arr1 := slices.Clone(original)
+	sorting.QuicksortLomuto(arr1, 0, len(arr1)-1)
+	fmt.Println("1. Lomuto Partition:", arr1)
+
+	// 2. Hoare
+	arr2 := slices.Clone(original)
+	sorting.QuicksortHoare(arr2, 0, len(arr2)-1)
+	fmt.Println("2. Hoare Partition:", arr2)
+
+	// 2b. Hoare with last element pivot
+	arr2b := slices.Clone(original)
+	sorting.QuicksortHoareLastPivot(arr2b, 0, len(arr2b)-1)
+	fmt.Println("2b. Hoare Partition (last element pivot):", arr2b)
+
+	// 2c. Hoare with mid element pivot
+	arr2c := slices.Clone(original)
+	sorting.QuicksortHoareMidPivot(arr2c, 0, len(arr2c)-1)
+	fmt.Println("2c. Hoare Partition (mid element pivot):", arr2c)
+
+	// 3. Three-Way
+	arr3 := slices.Clone(original)
+	sorting.QuicksortThreeWay(arr3, 0, len(arr3)-1)
+	fmt.Println("3. Three-Way (Dutch National Flag):", arr3)
+
+	// 4. Randomized
+	arr4 := slices.Clone(original)
+	sorting.QuicksortRandomized(arr4, 0, len(arr4)-1)
+	fmt.Println("4. Randomized Quicksort:", arr4)
+
+	// 5. Iterative
+	arr5 := slices.Clone(original)
+	sorting.QuicksortIterative(arr5, 0, len(arr5)-1)
+	fmt.Println("5. Iterative Quicksort:", arr5)
+
+	// 6. Hybrid
+	arr6 := slices.Clone(original)
+	sorting.QuicksortHybrid(arr6, 0, len(arr6)-1)
+	fmt.Println("6. Hybrid Quicksort:", arr6)
+
+	// 7. Dual-Pivot
+	arr7 := slices.Clone(original)
+	sorting.QuicksortDualPivot(arr7, 0, len(arr7)-1)
+	fmt.Println("7. Dual-Pivot Quicksort:", arr7)
+
+	// 8. Parallel
+	arr8 := slices.Clone(original)
+	sorting.QuickSortParallel(arr8, 0) // one worker per GOMAXPROCS
+	fmt.Println("8. Parallel Quicksort:", arr8)
+}
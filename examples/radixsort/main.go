@@ -0,0 +1,130 @@
+// Command radixsort demonstrates the radix and bucket sort variants.
+package main
+
+import (
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/RaviParvadiya/learn_dsa/sorting"
+)
+
+func main() {
+	fmt.Println("============ RADIX SORT ============")
+
+	arr0 := []int{33, 45, 40, 25, 17, 24}
+	sorting.RadixSort(arr0)
+	fmt.Println("\n0. Bucketed Radix Sort:", arr0)
+
+	// Test data
+	intArr := []int{170, 45, 75, 90, 802, 24, 2, 66}
+
+	// 1. LSD Radix Sort
+	arr1 := slices.Clone(intArr)
+	fmt.Println("\n1. LSD Radix Sort:")
+	fmt.Println("   Original:", arr1)
+	sorting.RadixSortLSD(arr1)
+	fmt.Println("   Sorted:", arr1)
+
+	// 2. MSD Radix Sort
+	arr2 := slices.Clone(intArr)
+	fmt.Println("\n2. MSD Radix Sort:")
+	fmt.Println("   Original:", arr2)
+	sorting.RadixSortMSD(arr2)
+	fmt.Println("   Sorted:", arr2)
+
+	// 3. Binary Radix Sort
+	arr3 := slices.Clone(intArr)
+	fmt.Println("\n3. Binary Radix Sort:")
+	fmt.Println("   Original:", arr3)
+	sorting.RadixSortBinary(arr3)
+	fmt.Println("   Sorted:", arr3)
+
+	// 4. With Negatives
+	arr4 := []int{170, -45, 75, -90, 24, -2, 66}
+	fmt.Println("\n4. Radix Sort with Negatives:")
+	fmt.Println("   Original:", arr4)
+	sorting.RadixSortWithNegatives(arr4)
+	fmt.Println("   Sorted:", arr4)
+
+	// 5. Base-16 Radix Sort
+	arr5 := slices.Clone(intArr)
+	fmt.Println("\n5. Radix Sort Base-16 (Hexadecimal):")
+	fmt.Println("   Original:", arr5)
+	sorting.RadixSortBase16(arr5)
+	fmt.Println("   Sorted:", arr5)
+
+	// 6. In-Place MSD Radix Sort
+	arr6 := slices.Clone(intArr)
+	fmt.Println("\n6. In-Place MSD Radix Sort:")
+	fmt.Println("   Original:", arr6)
+	max := slices.Max(arr6)
+	exp := 1
+	for max/exp >= 10 {
+		exp *= 10
+	}
+	sorting.RadixSortMSDInPlace(arr6, 0, len(arr6)-1, exp)
+	fmt.Println("   Sorted:", arr6)
+
+	// 7. String Radix Sort
+	strArr := []string{"abc", "aaa", "bcd", "bbb", "xyz", "aab"}
+	fmt.Println("\n7. String Radix Sort:")
+	fmt.Println("   Original:", strArr)
+	sorting.RadixSortStrings(strArr)
+	fmt.Println("   Sorted:", strArr)
+
+	// 8. Parallel Radix Sort (8-bit digits)
+	keys := []uint64{170, 45, 75, 90, 802, 24, 2, 66, 1 << 40}
+	fmt.Println("\n8. Parallel Radix Sort (uint64 keys):")
+	fmt.Println("   Original:", keys)
+	sorting.RadixSortParallel(keys, 0)
+	fmt.Println("   Sorted:", keys)
+
+	// 9. Radix Sort over bit patterns (any integer type, full range)
+	ints := []int64{math.MaxInt64, -1, 42, math.MinInt64, 0, -42}
+	fmt.Println("\n9. Radix Sort (int64 bit patterns):")
+	fmt.Println("   Original:", ints)
+	sorting.RadixSortIntegers(ints)
+	fmt.Println("   Sorted:", ints)
+
+	// 10. Float Radix Sort (IEEE-754 keys; NaN first, -0 equal to +0)
+	floats := []float64{3.5, math.Inf(-1), math.Copysign(0, -1), math.NaN(), -1e300, 0, math.Inf(1), -2.25}
+	fmt.Println("\n10. Float Radix Sort:")
+	fmt.Println("    Original:", floats)
+	sorting.RadixSortFloats(floats)
+	fmt.Println("    Sorted:", floats)
+
+	// 11. MSD (American flag) String Radix Sort, variable lengths
+	names := []string{"Zoë", "Ana", "Ángel", "Anabel", "李", "An", "Zoe"}
+	fmt.Println("\n11. MSD String Radix Sort (by code point):")
+	fmt.Println("    Original:", names)
+	sorting.RadixSortStringsMSDRunes(names)
+	fmt.Println("    Sorted:", names)
+
+	fmt.Println("\n============ BUCKET SORT ============")
+
+	// 1. Basic Bucket Sort (floats 0.0 to 1.0)
+	floatArr := []float64{0.897, 0.565, 0.656, 0.1234, 0.665, 0.3434}
+	fmt.Println("\n1. Basic Bucket Sort (floats):")
+	fmt.Println("   Original:", floatArr)
+	sorting.BucketSortBasic(floatArr)
+	fmt.Println("   Sorted:", floatArr)
+
+	// 2. Bucket Sort for Integers
+	arrB2 := []int{29, 25, 3, 49, 9, 37, 21, 43}
+	fmt.Println("\n2. Bucket Sort (integers):")
+	fmt.Println("   Original:", arrB2)
+	sorting.BucketSortIntegers(arrB2)
+	fmt.Println("   Sorted:", arrB2)
+
+	// 3. Fixed Buckets
+	arrB3 := slices.Clone(intArr)
+	fmt.Println("\n3. Bucket Sort (5 fixed buckets):")
+	fmt.Println("   Original:", arrB3)
+	sorting.BucketSortFixedBuckets(arrB3, 5)
+	fmt.Println("   Sorted:", arrB3)
+
+	fmt.Println("\n========================================")
+	fmt.Println("Note: Radix sort works best with counting")
+	fmt.Println("sort as subroutine for O(d*n) complexity!")
+}
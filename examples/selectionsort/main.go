@@ -0,0 +1,79 @@
+// Command selectionsort demonstrates the selection sort variants.
+package main
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/RaviParvadiya/learn_dsa/comparator"
+	"github.com/RaviParvadiya/learn_dsa/lists"
+	"github.com/RaviParvadiya/learn_dsa/records"
+	"github.com/RaviParvadiya/learn_dsa/sorting"
+)
+
+func main() {
+	original := []int{64, 25, 12, 22, 11, 90, 88, 34}
+
+	fmt.Println("Original array:", original)
+	fmt.Println()
+
+	// 1. Basic
+	arr1 := slices.Clone(original)
+	sorting.SelectionSortBasic(arr1)
+	fmt.Println("1. Basic Selection Sort:", arr1)
+
+	// 2. Descending
+	arr2 := slices.Clone(original)
+	sorting.SelectionSortDescending(arr2)
+	fmt.Println("2. Selection Sort (descending):", arr2)
+
+	// 3. Bidirectional
+	arr3 := slices.Clone(original)
+	sorting.SelectionSortBidirectional(arr3)
+	fmt.Println("3. Bidirectional Selection Sort:", arr3)
+
+	// 4. Recursive
+	arr4 := slices.Clone(original)
+	sorting.SelectionSortRecursive(arr4, 0)
+	fmt.Println("4. Recursive Selection Sort:", arr4)
+
+	// 5. Stable
+	arr5 := []int{4, 5, 3, 2, 4, 1}
+	fmt.Println("\n5. Stable Selection Sort:")
+	fmt.Println("   Original:", arr5)
+	sorting.SelectionSortStable(arr5)
+	fmt.Println("   Sorted:", arr5)
+
+	// 6. Early Termination
+	arr6 := []int{11, 12, 22, 25, 34, 64, 88, 90}
+	fmt.Println("\n6. Early Termination (already sorted):", arr6)
+	sorting.SelectionSortEarlyTermination(arr6)
+	fmt.Println("   Result:", arr6)
+
+	// 7. Linked List
+	head := lists.FromSlice([]int{64, 25, 12, 22, 11})
+	head = lists.SelectionSort(head)
+	fmt.Println("\n7. Linked List Selection Sort:", head)
+
+	// 8. Custom Comparator (descending)
+	arr8 := slices.Clone(original)
+	sorting.SelectionSortCustom(arr8, comparator.Reverse(comparator.Natural[int]()))
+	fmt.Println("\n8. Custom Comparator (descending):", arr8)
+
+	// 9. Objects
+	people := []records.Person{
+		{Name: "Alice", Age: 30},
+		{Name: "Bob", Age: 25},
+		{Name: "Charlie", Age: 35},
+		{Name: "David", Age: 20},
+	}
+	fmt.Println("\n9. Selection Sort for Objects:")
+	fmt.Println("   Original:", people)
+	sorting.SelectionSortObjects(people)
+	fmt.Println("   Sorted by Age:", people)
+
+	// 10. Optimized
+	arr10 := slices.Clone(original)
+	sorting.SelectionSortOptimized(arr10)
+	fmt.Println("\n10. Optimized Selection Sort:", arr10)
+}
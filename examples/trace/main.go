@@ -0,0 +1,51 @@
+// Command trace demonstrates recording, exporting and replaying an execution
+// trace.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+
+	"github.com/RaviParvadiya/learn_dsa/sorting"
+	"github.com/RaviParvadiya/learn_dsa/trace"
+)
+
+func main() {
+	input := []int{5, 2, 8, 1, 9, 3}
+
+	// 1. Record
+	alg, _ := sorting.Lookup("QuicksortHoare")
+	t, stats := trace.Record(alg.Name, input, alg.Sort)
+	fmt.Printf("1. Recorded %s on %v: %d events (%d comparisons, %d swaps)\n",
+		t.Algorithm, t.Input, len(t.Events), stats.Comparisons, stats.Swaps)
+
+	// 2. Export as JSON Lines
+	var buf bytes.Buffer
+	if err := t.WriteJSONL(&buf); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println("\n2. JSON Lines (first 5 lines):")
+	for i, line := range bytes.SplitN(buf.Bytes(), []byte("\n"), 6)[:5] {
+		fmt.Printf("   %d: %s\n", i+1, line)
+	}
+
+	// 3. Read back and replay, showing every state change
+	loaded, err := trace.ReadJSONL(&buf)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println("\n3. Replay:")
+	fmt.Printf("   %-16s %v\n", "start", loaded.Input)
+	final, err := loaded.Replay(func(step int, e trace.Event, arr []int) bool {
+		switch e.Op {
+		case trace.OpSwap, trace.OpWrite, trace.OpSplit:
+			fmt.Printf("   %-16s %v\n", e, arr)
+		}
+		return true
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println("   Final:", final)
+}
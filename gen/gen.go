@@ -0,0 +1,125 @@
+// Package gen generates input slices for demonstrating and measuring the
+// sorting algorithms.
+//
+// Every distribution yields n values in [0, n), so the output is valid
+// input for the non-negative-only counting and radix sorts too.
+package gen
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"slices"
+	"strings"
+)
+
+// Distribution is a named way of arranging n values.
+type Distribution struct {
+	Name     string
+	Generate func(n int, rng *rand.Rand) []int
+}
+
+// Distributions returns every known distribution.
+func Distributions() []Distribution {
+	return slices.Clone(distributions)
+}
+
+// Lookup returns the distribution with the given name, ignoring case.
+func Lookup(name string) (Distribution, bool) {
+	for _, d := range distributions {
+		if strings.EqualFold(d.Name, name) {
+			return d, true
+		}
+	}
+	return Distribution{}, false
+}
+
+// Ints returns n values arranged by the named distribution, using seed for
+// any randomness so runs can be reproduced.
+func Ints(name string, n int, seed int64) ([]int, error) {
+	d, ok := Lookup(name)
+	if !ok {
+		return nil, fmt.Errorf("gen: unknown distribution %q", name)
+	}
+	return d.Generate(n, rand.New(rand.NewSource(seed))), nil
+}
+
+var distributions = []Distribution{
+	{"random", random},
+	{"sorted", sorted},
+	{"reversed", reversed},
+	{"few-unique", fewUnique},
+	{"organ-pipe", organPipe},
+	{"sawtooth", sawtooth},
+	{"nearly-sorted", nearlySorted},
+}
+
+// random is uniformly random values.
+func random(n int, rng *rand.Rand) []int {
+	arr := make([]int, n)
+	for i := range arr {
+		arr[i] = rng.Intn(n)
+	}
+	return arr
+}
+
+// sorted is 0, 1, ..., n-1.
+func sorted(n int, _ *rand.Rand) []int {
+	arr := make([]int, n)
+	for i := range arr {
+		arr[i] = i
+	}
+	return arr
+}
+
+// reversed is n-1, n-2, ..., 0.
+func reversed(n int, _ *rand.Rand) []int {
+	arr := make([]int, n)
+	for i := range arr {
+		arr[i] = n - 1 - i
+	}
+	return arr
+}
+
+// fewUnique is random values drawn from only 10 distinct ones.
+func fewUnique(n int, rng *rand.Rand) []int {
+	const k = 10
+	arr := make([]int, n)
+	for i := range arr {
+		arr[i] = rng.Intn(k) * n / k
+	}
+	return arr
+}
+
+// organPipe rises to the middle and falls again: 0, 2, 4, ..., 4, 2, 0.
+func organPipe(n int, _ *rand.Rand) []int {
+	arr := make([]int, n)
+	for i := range arr {
+		arr[i] = 2 * min(i, n-1-i)
+	}
+	return arr
+}
+
+// sawtooth is about sqrt(n) ascending runs of about sqrt(n) values each.
+func sawtooth(n int, _ *rand.Rand) []int {
+	period := max(int(math.Sqrt(float64(n))), 2)
+	arr := make([]int, n)
+	for i := range arr {
+		arr[i] = i % period * n / period
+	}
+	return arr
+}
+
+// nearlySorted is sorted with 1% of its values (at least one pair) swapped
+// at random.
+func nearlySorted(n int, rng *rand.Rand) []int {
+	arr := sorted(n, rng)
+	if n < 2 {
+		return arr
+	}
+	for range max(n/100, 1) {
+		i, j := rng.Intn(n), rng.Intn(n)
+		arr[i], arr[j] = arr[j], arr[i]
+	}
+	return arr
+}
@@ -0,0 +1,104 @@
+package gen_test
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/RaviParvadiya/learn_dsa/gen"
+)
+
+// TestDistributions checks that every distribution yields exactly n values
+// in [0, n), the same ones for the same seed.
+func TestDistributions(t *testing.T) {
+	for _, d := range gen.Distributions() {
+		for _, n := range []int{0, 1, 2, 7, 100, 1000} {
+			got := d.Generate(n, rand.New(rand.NewSource(1)))
+			if len(got) != n {
+				t.Errorf("%s: %d values for n=%d", d.Name, len(got), n)
+				continue
+			}
+			for i, v := range got {
+				if v < 0 || v >= n {
+					t.Errorf("%s: value %d at %d outside [0, %d)", d.Name, v, i, n)
+					break
+				}
+			}
+			again, err := gen.Ints(d.Name, n, 1)
+			if err != nil || !slices.Equal(got, again) {
+				t.Errorf("%s: Ints with the same seed gave %v, %v, want %v", d.Name, head(again), err, head(got))
+			}
+		}
+	}
+}
+
+func head(arr []int) []int {
+	return arr[:min(len(arr), 10)]
+}
+
+// TestShapes pins down the arrangement each distribution promises.
+func TestShapes(t *testing.T) {
+	const n = 1000
+	ints := func(name string, seed int64) []int {
+		t.Helper()
+		arr, err := gen.Ints(name, n, seed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return arr
+	}
+	identity := make([]int, n)
+	for i := range identity {
+		identity[i] = i
+	}
+
+	if got := ints("sorted", 1); !slices.Equal(got, identity) {
+		t.Errorf("sorted: %v", head(got))
+	}
+	rev := slices.Clone(identity)
+	slices.Reverse(rev)
+	if got := ints("reversed", 1); !slices.Equal(got, rev) {
+		t.Errorf("reversed: %v", head(got))
+	}
+	if got := ints("few-unique", 1); len(unique(got)) > 10 {
+		t.Errorf("few-unique: %d distinct values", len(unique(got)))
+	}
+	got := ints("organ-pipe", 1)
+	if peak := slices.Index(got, slices.Max(got)); !slices.IsSorted(got[:peak+1]) || !slices.IsSortedFunc(got[peak:], func(a, b int) int { return b - a }) {
+		t.Errorf("organ-pipe does not rise and fall: %v", head(got))
+	}
+
+	near := ints("nearly-sorted", 1)
+	if slices.Equal(near, identity) || !slices.Equal(unique(near), identity) {
+		t.Errorf("nearly-sorted is not a shuffled permutation: %v", head(near))
+	}
+	out := 0
+	for i, v := range near {
+		if v != i {
+			out++
+		}
+	}
+	if out > 2*n/100 {
+		t.Errorf("nearly-sorted: %d values out of place, want at most %d", out, 2*n/100)
+	}
+
+	if slices.Equal(ints("random", 1), ints("random", 2)) {
+		t.Error("random gave the same values for different seeds")
+	}
+}
+
+// unique returns the distinct values of arr in order.
+func unique(arr []int) []int {
+	s := slices.Clone(arr)
+	slices.Sort(s)
+	return slices.Compact(s)
+}
+
+func TestLookup(t *testing.T) {
+	if d, ok := gen.Lookup("Nearly-Sorted"); !ok || d.Name != "nearly-sorted" {
+		t.Errorf("Lookup ignoring case: %v, %t", d.Name, ok)
+	}
+	if arr, err := gen.Ints("bogus", 10, 1); err == nil {
+		t.Errorf("Ints of an unknown distribution gave %v", arr)
+	}
+}
@@ -0,0 +1,3 @@
+module github.com/RaviParvadiya/learn_dsa
+
+go 1.22
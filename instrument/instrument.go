@@ -0,0 +1,146 @@
+// Package instrument counts the primitive operations a sort performs.
+//
+// The sorting implementations report each comparison, swap and element
+// write to a *Probe, together with the auxiliary buffers they allocate and
+// how deeply they recurse. Every Probe method is a no-op on a nil receiver,
+// so an algorithm runs uninstrumented when handed a nil Probe.
+//
+// A Probe can also forward each operation, along with the ranges a divide
+// and conquer sort splits and merges, to an Observer; the trace package
+// uses this to record a replayable event stream.
+package instrument
+
+// Stats holds the operation counts collected by a Probe.
+type Stats struct {
+	Comparisons int // element comparisons
+	Swaps       int // exchanges of two elements of the input
+	Writes      int // single-element stores outside of swaps
+	Allocs      int // auxiliary buffers allocated
+	AllocElems  int // total length of the auxiliary buffers
+	MaxDepth    int // deepest recursion (or explicit stack) level reached
+}
+
+// Observer receives the operations reported to a Probe as they happen.
+// Indices are positions in the slice being sorted; Write is called after the
+// store, so the new value can be read from the slice.
+type Observer interface {
+	Compare(i, j int)
+	Swap(i, j int)
+	Write(i int)
+	Split(low, high int)
+	Merge(low, mid, high int)
+}
+
+// Probe accumulates Stats for one run of an algorithm. The zero value is
+// ready to use.
+type Probe struct {
+	Stats
+
+	// Observer, if set, is told about every operation.
+	Observer Observer
+
+	depth int
+}
+
+// Compare records a comparison between the elements at positions i and j of
+// the input. An index of -1 stands for a value held outside the input, such
+// as a pivot or the key being inserted.
+func (p *Probe) Compare(i, j int) {
+	if p != nil {
+		p.Comparisons++
+		if p.Observer != nil {
+			p.Observer.Compare(i, j)
+		}
+	}
+}
+
+// Swap records that the elements at positions i and j were exchanged.
+func (p *Probe) Swap(i, j int) {
+	if p != nil {
+		p.Swaps++
+		if p.Observer != nil {
+			p.Observer.Swap(i, j)
+		}
+	}
+}
+
+// Write records a store into position i of the input.
+func (p *Probe) Write(i int) {
+	if p != nil {
+		p.Writes++
+		if p.Observer != nil {
+			p.Observer.Write(i)
+		}
+	}
+}
+
+// Alloc records an auxiliary buffer of n elements.
+func (p *Probe) Alloc(n int) {
+	if p != nil {
+		p.Allocs++
+		p.AllocElems += n
+	}
+}
+
+// Split records that the range [low, high] is about to be divided and its
+// parts sorted separately.
+func (p *Probe) Split(low, high int) {
+	if p != nil && p.Observer != nil {
+		p.Observer.Split(low, high)
+	}
+}
+
+// Merge records that the sorted ranges [low, mid] and [mid+1, high] are
+// about to be merged.
+func (p *Probe) Merge(low, mid, high int) {
+	if p != nil && p.Observer != nil {
+		p.Observer.Merge(low, mid, high)
+	}
+}
+
+// Enter records entry into a recursive call or a push onto an explicit
+// stack; it must be paired with Leave.
+func (p *Probe) Enter() {
+	if p != nil {
+		p.depth++
+		if p.depth > p.MaxDepth {
+			p.MaxDepth = p.depth
+		}
+	}
+}
+
+// Leave records the return from a call recorded with Enter.
+func (p *Probe) Leave() {
+	if p != nil {
+		p.depth--
+	}
+}
+
+// Scratch returns a Probe for sorting an auxiliary buffer, whose positions
+// do not correspond to the input, or nil if p is nil. It has no Observer.
+// Fold its counts back into p with Fold.
+func (p *Probe) Scratch() *Probe {
+	if p == nil {
+		return nil
+	}
+	return &Probe{}
+}
+
+// Fold adds the counts collected by a Scratch probe to p. The scratch work
+// is taken to have run at p's current depth.
+func (p *Probe) Fold(sub *Probe) {
+	if p == nil || sub == nil {
+		return
+	}
+	p.Comparisons += sub.Comparisons
+	p.Swaps += sub.Swaps
+	p.Writes += sub.Writes
+	p.Allocs += sub.Allocs
+	p.AllocElems += sub.AllocElems
+	p.MaxDepth = max(p.MaxDepth, p.depth+sub.MaxDepth)
+}
+
+// Reset clears the collected Stats, keeping the Observer.
+func (p *Probe) Reset() {
+	*p = Probe{Observer: p.Observer}
+}
@@ -0,0 +1,140 @@
+package instrument_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/RaviParvadiya/learn_dsa/instrument"
+	"github.com/RaviParvadiya/learn_dsa/sorting"
+)
+
+// log is an Observer writing down each call.
+type log []string
+
+func (l *log) Compare(i, j int)    { *l = append(*l, fmt.Sprint("compare ", i, " ", j)) }
+func (l *log) Swap(i, j int)       { *l = append(*l, fmt.Sprint("swap ", i, " ", j)) }
+func (l *log) Write(i int)         { *l = append(*l, fmt.Sprint("write ", i)) }
+func (l *log) Split(low, high int) { *l = append(*l, fmt.Sprint("split ", low, " ", high)) }
+func (l *log) Merge(low, mid, high int) {
+	*l = append(*l, fmt.Sprint("merge ", low, " ", mid, " ", high))
+}
+
+// TestSortCounts runs sorts worked through by hand on [3 1 2] and checks
+// the exact counts and the calls the Observer sees.
+func TestSortCounts(t *testing.T) {
+	tests := []struct {
+		name  string
+		stats instrument.Stats
+		calls []string
+	}{
+		{
+			"InsertionSortSwapping",
+			instrument.Stats{Comparisons: 3, Swaps: 2},
+			[]string{"compare 1 0", "swap 1 0", "compare 2 1", "swap 2 1", "compare 1 0"},
+		},
+		{
+			"InsertionSortBasic",
+			instrument.Stats{Comparisons: 3, Writes: 4},
+			[]string{"compare -1 0", "write 1", "write 0", "compare -1 1", "write 2", "compare -1 0", "write 1"},
+		},
+		{
+			"MergeSort1",
+			instrument.Stats{Comparisons: 3, Writes: 5, Allocs: 2, AllocElems: 5, MaxDepth: 3},
+			[]string{
+				"split 0 2", "split 0 1",
+				"merge 0 0 1", "compare 1 0", "write 0", "write 1",
+				"merge 0 1 2", "compare 2 0", "compare 2 1", "write 0", "write 1", "write 2",
+			},
+		},
+	}
+	for _, tt := range tests {
+		a, ok := sorting.Lookup(tt.name)
+		if !ok {
+			t.Fatalf("no algorithm %s", tt.name)
+		}
+		var calls log
+		p := &instrument.Probe{Observer: &calls}
+		arr := []int{3, 1, 2}
+		a.Sort(arr, p)
+		if !slices.Equal(arr, []int{1, 2, 3}) {
+			t.Errorf("%s: sorted to %v", tt.name, arr)
+		}
+		if p.Stats != tt.stats {
+			t.Errorf("%s: counted %+v, want %+v", tt.name, p.Stats, tt.stats)
+		}
+		if !slices.Equal(calls, tt.calls) {
+			t.Errorf("%s: observed %q, want %q", tt.name, calls, tt.calls)
+		}
+	}
+}
+
+func TestDepth(t *testing.T) {
+	var p instrument.Probe
+	p.Enter()
+	p.Enter()
+	p.Leave()
+	p.Enter()
+	p.Enter()
+	p.Leave()
+	p.Leave()
+	if p.MaxDepth != 3 {
+		t.Errorf("MaxDepth = %d, want 3", p.MaxDepth)
+	}
+
+	// Scratch work is folded in at the depth it ran, here 1, so three
+	// levels within it reach 4.
+	sub := p.Scratch()
+	sub.Compare(0, 1)
+	sub.Alloc(4)
+	sub.Enter()
+	sub.Enter()
+	sub.Enter()
+	p.Fold(sub)
+	want := instrument.Stats{Comparisons: 1, Allocs: 1, AllocElems: 4, MaxDepth: 4}
+	if p.Stats != want {
+		t.Errorf("after Fold: %+v, want %+v", p.Stats, want)
+	}
+}
+
+// TestScratchUnobserved checks that a Scratch probe counts without telling
+// the Observer, whose indices would not be positions in the input.
+func TestScratchUnobserved(t *testing.T) {
+	var calls log
+	p := &instrument.Probe{Observer: &calls}
+	sub := p.Scratch()
+	sub.Compare(0, 1)
+	sub.Swap(0, 1)
+	sub.Write(0)
+	p.Fold(sub)
+	if len(calls) != 0 {
+		t.Errorf("Observer saw scratch work: %q", calls)
+	}
+	if p.Comparisons != 1 || p.Swaps != 1 || p.Writes != 1 {
+		t.Errorf("after Fold: %+v", p.Stats)
+	}
+
+	p.Reset()
+	if p.Stats != (instrument.Stats{}) || p.Observer == nil {
+		t.Errorf("Reset left %+v, Observer %v", p.Stats, p.Observer)
+	}
+}
+
+// TestNil checks that every method is a no-op on a nil Probe, so the sorts
+// run uninstrumented without checking.
+func TestNil(t *testing.T) {
+	var p *instrument.Probe
+	p.Compare(0, 1)
+	p.Swap(0, 1)
+	p.Write(0)
+	p.Alloc(10)
+	p.Split(0, 1)
+	p.Merge(0, 0, 1)
+	p.Enter()
+	p.Leave()
+	if sub := p.Scratch(); sub != nil {
+		t.Errorf("Scratch of nil = %v, want nil", sub)
+	}
+	p.Fold(&instrument.Probe{})
+	(&instrument.Probe{}).Fold(nil)
+}
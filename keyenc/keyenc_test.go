@@ -0,0 +1,179 @@
+package keyenc_test
+
+import (
+	"cmp"
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/RaviParvadiya/learn_dsa/keyenc"
+)
+
+// tuple is a composite key of every field type, with a variable-length
+// field on either side of the fixed-width ones.
+type tuple struct {
+	s    string
+	i    int64
+	u    uint64
+	f    float64
+	b    bool
+	t    time.Time
+	rest string
+}
+
+// orders is the direction of each field of a tuple.
+type orders [7]keyenc.Order
+
+func (x tuple) key(o orders) string {
+	return keyenc.New().
+		String(x.s, o[0]).
+		Int(x.i, o[1]).
+		Uint(x.u, o[2]).
+		Float(x.f, o[3]).
+		Bool(x.b, o[4]).
+		Time(x.t, o[5]).
+		String(x.rest, o[6]).
+		Key()
+}
+
+// compare is the order keys must have: field by field, each in its
+// direction.
+func compare(x, y tuple, o orders) int {
+	cs := []int{
+		strings.Compare(x.s, y.s),
+		cmp.Compare(x.i, y.i),
+		cmp.Compare(x.u, y.u),
+		cmp.Compare(x.f, y.f),
+		cmp.Compare(boolInt(x.b), boolInt(y.b)),
+		x.t.Compare(y.t),
+		strings.Compare(x.rest, y.rest),
+	}
+	for k, c := range cs {
+		if c != 0 {
+			if o[k] == keyenc.Desc {
+				return -c
+			}
+			return c
+		}
+	}
+	return 0
+}
+
+func boolInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// Field values are drawn from small sets of edge cases, so that random
+// tuples often tie on leading fields.
+var (
+	strs = []string{
+		"", "\x00", "\x00\x00", "\x00\x01", "\x00\xff", "\x01", "\xff", "\xff\xff",
+		"a", "a\x00", "a\x00b", "a\x01", "ab", "abc", "b",
+	}
+	ints   = []int64{math.MinInt64, math.MinInt64 + 1, -1, 0, 1, math.MaxInt64}
+	uints  = []uint64{0, 1, 1 << 63, math.MaxUint64}
+	floats = []float64{
+		math.NaN(), math.Float64frombits(0xfff8000000000001), math.Inf(-1), -math.MaxFloat64, -1,
+		-math.SmallestNonzeroFloat64, math.Copysign(0, -1), 0, math.SmallestNonzeroFloat64,
+		1, math.MaxFloat64, math.Inf(1),
+	}
+	zones = []*time.Location{time.UTC, time.FixedZone("east", 5*3600+1800), time.FixedZone("west", -8*3600)}
+	times = []time.Time{
+		time.Date(1969, 12, 31, 23, 59, 59, 999999999, time.UTC),
+		time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(1970, 1, 1, 0, 0, 0, 1, time.UTC),
+		time.Date(2024, 2, 29, 12, 0, 0, 0, time.UTC),
+		time.Date(2024, 2, 29, 12, 0, 0, 500, time.UTC),
+		time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+)
+
+func randomTuple(rng *rand.Rand) tuple {
+	return tuple{
+		s:    strs[rng.Intn(len(strs))],
+		i:    ints[rng.Intn(len(ints))],
+		u:    uints[rng.Intn(len(uints))],
+		f:    floats[rng.Intn(len(floats))],
+		b:    rng.Intn(2) == 1,
+		t:    times[rng.Intn(len(times))].In(zones[rng.Intn(len(zones))]),
+		rest: strs[rng.Intn(len(strs))],
+	}
+}
+
+// TestKeyOrder checks that comparing the keys of random pairs of tuples
+// gives the order of the tuples, for random field directions.
+func TestKeyOrder(t *testing.T) {
+	rng := rand.New(rand.NewSource(9))
+	for range 20000 {
+		var o orders
+		for k := range o {
+			o[k] = keyenc.Order(rng.Intn(2) == 1)
+		}
+		x, y := randomTuple(rng), randomTuple(rng)
+		if got, want := cmp.Compare(x.key(o), y.key(o)), compare(x, y, o); got != want {
+			t.Fatalf("orders %v: keys of %+v and %+v compare %d, want %d", o, x, y, got, want)
+		}
+	}
+}
+
+// TestStringPrefixes checks every pair of strings alone, where one being a
+// prefix of the other or holding zero bytes matters most, in both
+// directions.
+func TestStringPrefixes(t *testing.T) {
+	for _, a := range strs {
+		for _, b := range strs {
+			want := strings.Compare(a, b)
+			asc := cmp.Compare(keyenc.New().String(a, keyenc.Asc).Key(), keyenc.New().String(b, keyenc.Asc).Key())
+			desc := cmp.Compare(keyenc.New().String(a, keyenc.Desc).Key(), keyenc.New().String(b, keyenc.Desc).Key())
+			if asc != want || desc != -want {
+				t.Errorf("%q vs %q: asc %d, desc %d, want %d", a, b, asc, desc, want)
+			}
+		}
+	}
+}
+
+func TestFloatEquivalence(t *testing.T) {
+	key := func(f float64) string { return keyenc.New().Float(f, keyenc.Asc).Key() }
+	if key(math.Copysign(0, -1)) != key(0) {
+		t.Error("-0 and +0 encode differently")
+	}
+	if key(math.NaN()) != key(math.Float64frombits(0xfff8000000000001)) {
+		t.Error("NaNs encode differently")
+	}
+	if key(math.NaN()) >= key(math.Inf(-1)) {
+		t.Error("NaN does not sort before -Inf")
+	}
+}
+
+func TestTimeLocations(t *testing.T) {
+	key := func(t time.Time) string { return keyenc.New().Time(t, keyenc.Asc).Key() }
+	for _, tm := range times {
+		for _, z := range zones {
+			if key(tm.In(z)) != key(tm) {
+				t.Errorf("%v in %v encodes differently", tm, z)
+			}
+		}
+	}
+	// 10:00 in the west is later than 12:00 in UTC.
+	west := time.Date(2024, 1, 1, 10, 0, 0, 0, zones[2])
+	noon := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	if key(west) <= key(noon) {
+		t.Error("keys order times by wall clock, not by instant")
+	}
+}
+
+func TestReset(t *testing.T) {
+	e := keyenc.New()
+	first := e.String("abc", keyenc.Asc).Int(-5, keyenc.Desc).Key()
+	if again := e.Reset().String("abc", keyenc.Asc).Int(-5, keyenc.Desc).Key(); again != first {
+		t.Errorf("after Reset got %q, want %q", again, first)
+	}
+	if string(e.Bytes()) != first {
+		t.Errorf("Bytes = %q, want %q", e.Bytes(), first)
+	}
+}
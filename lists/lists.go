@@ -0,0 +1,45 @@
+// Package lists implements sorting for singly linked lists.
+//
+// Each sort comes in two forms: Xxx sorts a list of any cmp.Ordered element
+// type in ascending order, and XxxFunc orders elements by a caller-supplied
+// less function.
+package lists
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Node is a singly linked list node.
+type Node[T any] struct {
+	Data T
+	Next *Node[T]
+}
+
+// FromSlice builds a linked list holding the elements of arr in order.
+func FromSlice[T any](arr []T) *Node[T] {
+	if len(arr) == 0 {
+		return nil
+	}
+	head := &Node[T]{Data: arr[0]}
+	current := head
+	for i := 1; i < len(arr); i++ {
+		current.Next = &Node[T]{Data: arr[i]}
+		current = current.Next
+	}
+	return head
+}
+
+// String formats the list starting at head like a slice, e.g. "[1 2 3]".
+func (head *Node[T]) String() string {
+	var b strings.Builder
+	b.WriteString("[")
+	for current := head; current != nil; current = current.Next {
+		fmt.Fprint(&b, current.Data)
+		if current.Next != nil {
+			b.WriteString(" ")
+		}
+	}
+	b.WriteString("]")
+	return b.String()
+}
@@ -0,0 +1,167 @@
+package lists
+
+import "cmp"
+
+// BubbleSort sorts the list by swapping the data of adjacent nodes.
+func BubbleSort[T cmp.Ordered](head *Node[T]) *Node[T] {
+	return BubbleSortFunc(head, cmp.Less[T])
+}
+
+// BubbleSortFunc is BubbleSort ordered by less.
+func BubbleSortFunc[T any](head *Node[T], less func(a, b T) bool) *Node[T] {
+	if head == nil {
+		return nil
+	}
+
+	swapped := true
+
+	for swapped {
+		swapped = false
+		current := head
+
+		for current.Next != nil {
+			if less(current.Next.Data, current.Data) {
+				// Swap data
+				current.Data, current.Next.Data = current.Next.Data, current.Data
+				swapped = true
+			}
+			current = current.Next
+		}
+	}
+
+	return head
+}
+
+// InsertionSort sorts the list by relinking each node into a sorted list.
+func InsertionSort[T cmp.Ordered](head *Node[T]) *Node[T] {
+	return InsertionSortFunc(head, cmp.Less[T])
+}
+
+// InsertionSortFunc is InsertionSort ordered by less.
+func InsertionSortFunc[T any](head *Node[T], less func(a, b T) bool) *Node[T] {
+	if head == nil {
+		return nil
+	}
+
+	sorted := &Node[T]{} // Dummy node
+	current := head
+
+	for current != nil {
+		next := current.Next
+
+		// Find position to insert
+		prev := sorted
+		for prev.Next != nil && !less(current.Data, prev.Next.Data) {
+			prev = prev.Next
+		}
+
+		// Insert current node
+		current.Next = prev.Next
+		prev.Next = current
+
+		current = next
+	}
+
+	return sorted.Next
+}
+
+// SelectionSort sorts the list by swapping the minimum remaining data into
+// each node in turn.
+func SelectionSort[T cmp.Ordered](head *Node[T]) *Node[T] {
+	return SelectionSortFunc(head, cmp.Less[T])
+}
+
+// SelectionSortFunc is SelectionSort ordered by less.
+func SelectionSortFunc[T any](head *Node[T], less func(a, b T) bool) *Node[T] {
+	if head == nil {
+		return nil
+	}
+
+	current := head
+
+	for current != nil {
+		// Find minimum in remaining list
+		minNode := current
+		temp := current.Next
+
+		for temp != nil {
+			if less(temp.Data, minNode.Data) {
+				minNode = temp
+			}
+			temp = temp.Next
+		}
+
+		// Swap data
+		current.Data, minNode.Data = minNode.Data, current.Data
+
+		current = current.Next
+	}
+
+	return head
+}
+
+// MergeSort sorts the list by splitting it at the middle and merging the
+// sorted halves.
+func MergeSort[T cmp.Ordered](head *Node[T]) *Node[T] {
+	return MergeSortFunc(head, cmp.Less[T])
+}
+
+// MergeSortFunc is MergeSort ordered by less.
+func MergeSortFunc[T any](head *Node[T], less func(a, b T) bool) *Node[T] {
+	if head == nil || head.Next == nil {
+		return head
+	}
+
+	// Split the list into two halves
+	mid := getMiddle(head)
+	midNext := mid.Next
+	mid.Next = nil
+
+	// Recursively sort both halves
+	left := MergeSortFunc(head, less)
+	right := MergeSortFunc(midNext, less)
+
+	// Merge sorted halves
+	return mergeLists(left, right, less)
+}
+
+func getMiddle[T any](head *Node[T]) *Node[T] {
+	if head == nil {
+		return head
+	}
+
+	slow := head
+	fast := head.Next
+
+	for fast != nil && fast.Next != nil {
+		slow = slow.Next
+		fast = fast.Next.Next
+	}
+
+	return slow
+}
+
+func mergeLists[T any](left, right *Node[T], less func(a, b T) bool) *Node[T] {
+	dummy := &Node[T]{}
+	current := dummy
+
+	for left != nil && right != nil {
+		if !less(right.Data, left.Data) {
+			current.Next = left
+			left = left.Next
+		} else {
+			current.Next = right
+			right = right.Next
+		}
+		current = current.Next
+	}
+
+	if left != nil {
+		current.Next = left
+	}
+	if right != nil {
+		current.Next = right
+	}
+
+	return dummy.Next
+}
@@ -0,0 +1,238 @@
+// Package orderby sorts records on several fields from an ordering such as
+// "age desc, name asc", like the ORDER BY clause of SQL.
+//
+// The fields an ordering may name are declared in a Fields, either one by
+// one with a key function each or all at once from the struct tags of the
+// record type. Sorting makes one stable pass per key, from the last key to
+// the first, so that earlier keys take precedence and records equal on
+// every key keep their order. Each pass picks its algorithm: counting sort
+// for an integer field whose values span a range not much wider than the
+// number of records, and Timsort, a stable merge sort, for the rest.
+package orderby
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/RaviParvadiya/learn_dsa/comparator"
+	"github.com/RaviParvadiya/learn_dsa/sorting"
+)
+
+// Key is one term of an ordering: a field and its direction.
+type Key struct {
+	Field string
+	Desc  bool
+}
+
+// Asc is the key sorting field in ascending order.
+func Asc(field string) Key {
+	return Key{Field: field}
+}
+
+// Desc is the key sorting field in descending order.
+func Desc(field string) Key {
+	return Key{Field: field, Desc: true}
+}
+
+func (k Key) String() string {
+	if k.Desc {
+		return k.Field + " desc"
+	}
+	return k.Field + " asc"
+}
+
+// Parse parses an ordering: a comma-separated list of field names, each
+// optionally followed by asc or desc, ascending being the default.
+func Parse(spec string) ([]Key, error) {
+	var keys []Key
+	for _, term := range strings.Split(spec, ",") {
+		words := strings.Fields(term)
+		if len(words) == 0 || len(words) > 2 {
+			return nil, fmt.Errorf("orderby: bad term %q in %q", strings.TrimSpace(term), spec)
+		}
+		k := Key{Field: words[0]}
+		if len(words) == 2 {
+			switch strings.ToLower(words[1]) {
+			case "asc":
+			case "desc":
+				k.Desc = true
+			default:
+				return nil, fmt.Errorf("orderby: bad direction %q for field %s", words[1], words[0])
+			}
+		}
+		keys = append(keys, k)
+	}
+	return keys, nil
+}
+
+// countingSlack bounds the range of an integer field sorted with counting
+// sort to countingSlack times the number of records.
+const countingSlack = 4
+
+// field is how to sort by one field: by compare, or by counting sort on
+// intKey if it is set.
+type field[T any] struct {
+	compare comparator.Func[T]
+	intKey  func(T) int
+}
+
+// Fields is the set of fields of T an ordering may name. Names are matched
+// without regard to case.
+type Fields[T any] struct {
+	fields map[string]field[T]
+}
+
+// NewFields returns an empty set of fields.
+func NewFields[T any]() *Fields[T] {
+	return &Fields[T]{fields: make(map[string]field[T])}
+}
+
+func (f *Fields[T]) add(name string, fd field[T]) *Fields[T] {
+	f.fields[strings.ToLower(name)] = fd
+	return f
+}
+
+// Int declares an integer field, which is sorted by counting sort when its
+// range allows.
+func (f *Fields[T]) Int(name string, key func(T) int) *Fields[T] {
+	return f.add(name, field[T]{compare: comparator.ByKey(key), intKey: key})
+}
+
+// String declares a string field, sorted in byte order. Use Func with
+// collate.By for another collation.
+func (f *Fields[T]) String(name string, key func(T) string) *Fields[T] {
+	return f.add(name, field[T]{compare: comparator.ByKey(key)})
+}
+
+// Float declares a floating-point field, ordered as cmp.Compare orders it.
+func (f *Fields[T]) Float(name string, key func(T) float64) *Fields[T] {
+	return f.add(name, field[T]{compare: comparator.ByKey(key)})
+}
+
+// Func declares a field ordered by compare.
+func (f *Fields[T]) Func(name string, compare comparator.Func[T]) *Fields[T] {
+	return f.add(name, field[T]{compare: compare})
+}
+
+// Tags declares the exported fields of the struct type T of kind integer,
+// float, string or bool (false before true), including those promoted from
+// embedded structs. A field is named by its sort tag, or by its Go name if
+// it has none, and skipped if its tag is "-":
+//
+//	type Person struct {
+//		Name  string `sort:"name"`
+//		Age   int
+//		Notes string `sort:"-"`
+//	}
+//
+// Untagged fields of other kinds, such as a time.Time, are skipped; declare
+// them with Func on the Fields returned. Tags reports an error if a field
+// of another kind has a sort tag naming it.
+func Tags[T any]() (*Fields[T], error) {
+	t := reflect.TypeFor[T]()
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("orderby: %v is not a struct type", t)
+	}
+
+	f := NewFields[T]()
+	for _, sf := range reflect.VisibleFields(t) {
+		if !sf.IsExported() || sf.Anonymous {
+			continue
+		}
+		name := sf.Name
+		tag, tagged := sf.Tag.Lookup("sort")
+		if tagged {
+			if tag == "-" {
+				continue
+			}
+			name = tag
+		}
+		index := sf.Index
+		value := func(v T) reflect.Value {
+			return reflect.ValueOf(v).FieldByIndex(index)
+		}
+
+		switch sf.Type.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			f.Int(name, func(v T) int { return int(value(v).Int()) })
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			f.Func(name, comparator.ByKey(func(v T) uint64 { return value(v).Uint() }))
+		case reflect.Float32, reflect.Float64:
+			f.Float(name, func(v T) float64 { return value(v).Float() })
+		case reflect.String:
+			f.String(name, func(v T) string { return value(v).String() })
+		case reflect.Bool:
+			f.Func(name, func(a, b T) int { return compareBool(value(a).Bool(), value(b).Bool()) })
+		default:
+			if tagged {
+				return nil, fmt.Errorf("orderby: field %s of %v has unsupported type %v", sf.Name, t, sf.Type)
+			}
+		}
+	}
+	return f, nil
+}
+
+func compareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case !a:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// Sort stably sorts arr by the ordering spec (see Parse).
+func (f *Fields[T]) Sort(arr []T, spec string) error {
+	keys, err := Parse(spec)
+	if err != nil {
+		return err
+	}
+	return f.SortKeys(arr, keys...)
+}
+
+// SortKeys stably sorts arr by keys. It reports an error, leaving arr
+// unchanged, if a key names an undeclared field.
+func (f *Fields[T]) SortKeys(arr []T, keys ...Key) error {
+	fields := make([]field[T], len(keys))
+	for i, k := range keys {
+		fd, ok := f.fields[strings.ToLower(k.Field)]
+		if !ok {
+			return fmt.Errorf("orderby: unknown field %q", k.Field)
+		}
+		fields[i] = fd
+	}
+
+	for i := len(keys) - 1; i >= 0; i-- {
+		sortBy(arr, fields[i], keys[i].Desc)
+	}
+	return nil
+}
+
+// sortBy makes one stable pass over arr by fd.
+func sortBy[T any](arr []T, fd field[T], desc bool) {
+	if fd.intKey != nil && len(arr) > 1 {
+		lo, hi := fd.intKey(arr[0]), fd.intKey(arr[0])
+		for _, v := range arr {
+			k := fd.intKey(v)
+			lo, hi = min(lo, k), max(hi, k)
+		}
+		// uint(hi-lo) is the span even where hi-lo overflows.
+		if uint(hi-lo) < uint(countingSlack*len(arr)) {
+			key := fd.intKey
+			if desc {
+				key = func(v T) int { return hi - fd.intKey(v) }
+			}
+			copy(arr, sorting.CountingSortByKey(arr, key))
+			return
+		}
+	}
+
+	compare := fd.compare
+	if desc {
+		compare = comparator.Reverse(compare)
+	}
+	sorting.TimsortFunc(arr, compare.Less)
+}
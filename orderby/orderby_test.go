@@ -0,0 +1,265 @@
+package orderby
+
+import (
+	"math"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		spec string
+		want []Key
+	}{
+		{"age", []Key{Asc("age")}},
+		{"age desc, name", []Key{Desc("age"), Asc("name")}},
+		{"  Age DESC ,name ASC", []Key{Desc("Age"), Asc("name")}},
+		{"a,b desc,c asc", []Key{Asc("a"), Desc("b"), Asc("c")}},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.spec)
+		if err != nil || !slices.Equal(got, tt.want) {
+			t.Errorf("Parse(%q) = %v, %v, want %v", tt.spec, got, err, tt.want)
+		}
+	}
+
+	for _, spec := range []string{"", " ", "age,", ",age", "age desc name", "age descending", "age, , name"} {
+		if keys, err := Parse(spec); err == nil || !strings.HasPrefix(err.Error(), "orderby: ") {
+			t.Errorf("Parse(%q) = %v, %v, want an error", spec, keys, err)
+		}
+	}
+
+	if s := Desc("age").String() + ", " + Asc("name").String(); s != "age desc, name asc" {
+		t.Errorf("String gives %q", s)
+	}
+}
+
+type record struct {
+	Dept  string `sort:"dept"`
+	Age   int
+	Score float64 `sort:"score"`
+	Off   bool
+	Rank  uint8
+	Notes string `sort:"-"`
+	pos   int
+}
+
+func records(n int, ageSpan int, rng *rand.Rand) []record {
+	depts := []string{"dev", "ops", "qa"}
+	arr := make([]record, n)
+	for i := range arr {
+		arr[i] = record{
+			Dept:  depts[rng.Intn(len(depts))],
+			Age:   rng.Intn(ageSpan) - ageSpan/2,
+			Score: float64(rng.Intn(4)) / 2,
+			Off:   rng.Intn(2) == 1,
+			Rank:  uint8(rng.Intn(256)),
+			pos:   i,
+		}
+	}
+	return arr
+}
+
+// compareRecords orders records as "dept, age desc, score, off desc,
+// rank", then by position, which the passes must preserve.
+func compareRecords(a, b record) int {
+	switch {
+	case a.Dept != b.Dept:
+		return strings.Compare(a.Dept, b.Dept)
+	case a.Age != b.Age:
+		return b.Age - a.Age
+	case a.Score != b.Score:
+		if a.Score < b.Score {
+			return -1
+		}
+		return 1
+	case a.Off != b.Off:
+		return compareBool(b.Off, a.Off)
+	case a.Rank != b.Rank:
+		return int(a.Rank) - int(b.Rank)
+	}
+	return a.pos - b.pos
+}
+
+// TestSortStable sorts on several keys with both an age range narrow
+// enough for counting sort and one wide enough for Timsort, and checks
+// the result against a single sort on every key and the position.
+func TestSortStable(t *testing.T) {
+	fields, err := Tags[record]()
+	if err != nil {
+		t.Fatal(err)
+	}
+	rng := rand.New(rand.NewSource(10))
+	for _, span := range []int{10, 1 << 20} {
+		for _, n := range []int{0, 1, 2, 50, 1000} {
+			arr := records(n, span, rng)
+			want := slices.Clone(arr)
+			slices.SortFunc(want, compareRecords)
+			if err := fields.Sort(arr, "dept, AGE desc, score, off desc, rank"); err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(arr, want) {
+				t.Errorf("span %d, %d records: got %v, want %v", span, n, arr[:min(n, 5)], want[:min(n, 5)])
+			}
+		}
+	}
+}
+
+func TestSortErrors(t *testing.T) {
+	fields, err := Tags[record]()
+	if err != nil {
+		t.Fatal(err)
+	}
+	arr := records(20, 10, rand.New(rand.NewSource(11)))
+	orig := slices.Clone(arr)
+	for _, spec := range []string{"age desc, bogus", "notes", "pos", "age sideways"} {
+		if err := fields.Sort(arr, spec); err == nil {
+			t.Errorf("Sort by %q succeeded", spec)
+		}
+		if !slices.Equal(arr, orig) {
+			t.Fatalf("Sort by %q changed arr before failing", spec)
+		}
+	}
+}
+
+// TestSortByDispatch checks which algorithm a pass picks: counting sort
+// never compares, and Timsort never needs the integer key beyond the scan
+// for the range.
+func TestSortByDispatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		keys     []int
+		counting bool
+	}{
+		{"narrow", []int{5, 3, 5, 9, 3, 7, 3, 4}, true},
+		{"negative", []int{-5, -3, -5, -1, -3, -2}, true},
+		{"wide", []int{0, 1000, 3, 500}, false},
+		{"overflowing", []int{math.MinInt, math.MaxInt, 0, -1}, false},
+		{"extremes", []int{math.MaxInt, math.MaxInt - 1, math.MaxInt, math.MaxInt - 2}, true},
+		{"minimum", []int{math.MinInt, math.MinInt + 2, math.MinInt}, true},
+	}
+	type item struct{ key, pos int }
+	for _, tt := range tests {
+		for _, desc := range []bool{false, true} {
+			arr := make([]item, len(tt.keys))
+			for i, k := range tt.keys {
+				arr[i] = item{k, i}
+			}
+			compared := false
+			fd := field[item]{
+				compare: func(a, b item) int {
+					compared = true
+					return a.key - b.key
+				},
+				intKey: func(v item) int { return v.key },
+			}
+			if !tt.counting {
+				// The ranges too wide for counting sort overflow a.key - b.key.
+				fd.compare = func(a, b item) int {
+					compared = true
+					switch {
+					case a.key < b.key:
+						return -1
+					case a.key > b.key:
+						return 1
+					}
+					return 0
+				}
+			}
+			sortBy(arr, fd, desc)
+
+			if compared == tt.counting {
+				t.Errorf("%s, desc %t: counting sort %t, want %t", tt.name, desc, !compared, tt.counting)
+			}
+			for i := 1; i < len(arr); i++ {
+				a, b := arr[i-1], arr[i]
+				if desc {
+					a.key, b.key = b.key, a.key
+				}
+				if a.key > b.key || a.key == b.key && arr[i-1].pos > arr[i].pos {
+					t.Errorf("%s, desc %t: got %v", tt.name, desc, arr)
+					break
+				}
+			}
+		}
+	}
+}
+
+type Base struct {
+	ID      int `sort:"id"`
+	Created time.Time
+}
+
+type promoted struct {
+	Base
+	Name  string
+	Tags  []string
+	Owner *Base
+}
+
+func TestTagsPromotedAndSkipped(t *testing.T) {
+	fields, err := Tags[promoted]()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for name := range fields.fields {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	if want := []string{"id", "name"}; !slices.Equal(names, want) {
+		t.Errorf("declared %v, want %v", names, want)
+	}
+
+	arr := []promoted{{Base: Base{ID: 3}, Name: "c"}, {Base: Base{ID: 1}, Name: "a"}, {Base: Base{ID: 2}, Name: "b"}}
+	if err := fields.Sort(arr, "id"); err != nil {
+		t.Fatal(err)
+	}
+	if arr[0].Name != "a" || arr[1].Name != "b" || arr[2].Name != "c" {
+		t.Errorf("sorted by promoted id: %v", arr)
+	}
+}
+
+func TestTagsErrors(t *testing.T) {
+	if _, err := Tags[int](); err == nil {
+		t.Error("Tags of a non-struct type succeeded")
+	}
+	type event struct {
+		At time.Time `sort:"at"`
+	}
+	if _, err := Tags[event](); err == nil || !strings.Contains(err.Error(), "At") {
+		t.Errorf("Tags with a tagged time.Time field: %v, want an error naming it", err)
+	}
+}
+
+// TestFieldsDeclared covers fields declared one by one, names matched
+// without regard to case and a Func field added to Tags.
+func TestFieldsDeclared(t *testing.T) {
+	type event struct {
+		Name string
+		At   time.Time
+	}
+	fields, err := Tags[event]()
+	if err != nil {
+		t.Fatal(err)
+	}
+	fields.Func("At", func(a, b event) int { return a.At.Compare(b.At) })
+
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 0, 0, 0, 0, time.UTC) }
+	arr := []event{{"b", day(2)}, {"a", day(3)}, {"c", day(1)}, {"a", day(1)}}
+	if err := fields.Sort(arr, "name, at desc"); err != nil {
+		t.Fatal(err)
+	}
+	want := []event{{"a", day(3)}, {"a", day(1)}, {"b", day(2)}, {"c", day(1)}}
+	if !slices.EqualFunc(arr, want, func(x, y event) bool { return x.Name == y.Name && x.At.Equal(y.At) }) {
+		t.Errorf("got %v, want %v", arr, want)
+	}
+
+	f := NewFields[event]().String("NAME", func(e event) string { return e.Name })
+	if err := f.SortKeys(arr, Desc("name")); err != nil || arr[0].Name != "c" || arr[3].Name != "a" {
+		t.Errorf("SortKeys by name desc: %v, %v", arr, err)
+	}
+}
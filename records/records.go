@@ -0,0 +1,15 @@
+// Package records holds the small record types the sorting and searching
+// examples operate on.
+package records
+
+// Person is sorted and searched by Age.
+type Person struct {
+	Name string
+	Age  int
+}
+
+// Student is sorted by Score.
+type Student struct {
+	Name  string
+	Score int
+}
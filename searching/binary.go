@@ -0,0 +1,499 @@
+package searching
+
+import (
+	"cmp"
+	"math/bits"
+
+	"github.com/RaviParvadiya/learn_dsa/comparator"
+	"github.com/RaviParvadiya/learn_dsa/records"
+)
+
+// BinarySearchIterative is the basic iterative binary search.
+func BinarySearchIterative[T cmp.Ordered](arr []T, target T) int {
+	return BinarySearchIterativeBy(arr, target, identity[T])
+}
+
+// BinarySearchIterativeBy is BinarySearchIterative on key(arr[i]).
+func BinarySearchIterativeBy[T any, K cmp.Ordered](arr []T, target K, key func(T) K) int {
+	low := 0
+	high := len(arr) - 1
+
+	for low <= high {
+		mid := low + (high-low)/2
+		c := cmp.Compare(key(arr[mid]), target)
+
+		if c == 0 {
+			return mid
+		} else if c < 0 {
+			low = mid + 1
+		} else {
+			high = mid - 1
+		}
+	}
+
+	return -1
+}
+
+// BinarySearchRecursive is recursive binary search over arr[low..high].
+func BinarySearchRecursive[T cmp.Ordered](arr []T, target T, low, high int) int {
+	return BinarySearchRecursiveBy(arr, target, low, high, identity[T])
+}
+
+// BinarySearchRecursiveBy is BinarySearchRecursive on key(arr[i]).
+func BinarySearchRecursiveBy[T any, K cmp.Ordered](arr []T, target K, low, high int, key func(T) K) int {
+	if low > high {
+		return -1
+	}
+
+	mid := low + (high-low)/2
+	c := cmp.Compare(key(arr[mid]), target)
+
+	if c == 0 {
+		return mid
+	} else if c < 0 {
+		return BinarySearchRecursiveBy(arr, target, mid+1, high, key)
+	} else {
+		return BinarySearchRecursiveBy(arr, target, low, mid-1, key)
+	}
+}
+
+// BinarySearchFirst returns the index of the first (leftmost) occurrence of
+// target.
+func BinarySearchFirst[T cmp.Ordered](arr []T, target T) int {
+	return BinarySearchFirstBy(arr, target, identity[T])
+}
+
+// BinarySearchFirstBy is BinarySearchFirst on key(arr[i]).
+func BinarySearchFirstBy[T any, K cmp.Ordered](arr []T, target K, key func(T) K) int {
+	low := 0
+	high := len(arr) - 1
+	result := -1
+
+	for low <= high {
+		mid := low + (high-low)/2
+		c := cmp.Compare(key(arr[mid]), target)
+
+		if c == 0 {
+			result = mid
+			high = mid - 1 // Continue searching left
+		} else if c < 0 {
+			low = mid + 1
+		} else {
+			high = mid - 1
+		}
+	}
+
+	return result
+}
+
+// BinarySearchLast returns the index of the last (rightmost) occurrence of
+// target.
+func BinarySearchLast[T cmp.Ordered](arr []T, target T) int {
+	return BinarySearchLastBy(arr, target, identity[T])
+}
+
+// BinarySearchLastBy is BinarySearchLast on key(arr[i]).
+func BinarySearchLastBy[T any, K cmp.Ordered](arr []T, target K, key func(T) K) int {
+	low := 0
+	high := len(arr) - 1
+	result := -1
+
+	for low <= high {
+		mid := low + (high-low)/2
+		c := cmp.Compare(key(arr[mid]), target)
+
+		if c == 0 {
+			result = mid
+			low = mid + 1 // Continue searching right
+		} else if c < 0 {
+			low = mid + 1
+		} else {
+			high = mid - 1
+		}
+	}
+
+	return result
+}
+
+// BinarySearchCount returns the number of occurrences of target.
+func BinarySearchCount[T cmp.Ordered](arr []T, target T) int {
+	return BinarySearchCountBy(arr, target, identity[T])
+}
+
+// BinarySearchCountBy is BinarySearchCount on key(arr[i]).
+func BinarySearchCountBy[T any, K cmp.Ordered](arr []T, target K, key func(T) K) int {
+	first := BinarySearchFirstBy(arr, target, key)
+	if first == -1 {
+		return 0
+	}
+	last := BinarySearchLastBy(arr, target, key)
+	return last - first + 1
+}
+
+// BinarySearchLowerBound returns the index of the first element >= target.
+func BinarySearchLowerBound[T cmp.Ordered](arr []T, target T) int {
+	return BinarySearchLowerBoundBy(arr, target, identity[T])
+}
+
+// BinarySearchLowerBoundBy returns the index of the first element whose key
+// is >= target.
+func BinarySearchLowerBoundBy[T any, K cmp.Ordered](arr []T, target K, key func(T) K) int {
+	low := 0
+	high := len(arr)
+
+	for low < high {
+		mid := low + (high-low)/2
+
+		if cmp.Less(key(arr[mid]), target) {
+			low = mid + 1
+		} else {
+			high = mid
+		}
+	}
+
+	return low
+}
+
+// BinarySearchUpperBound returns the index of the first element > target.
+func BinarySearchUpperBound[T cmp.Ordered](arr []T, target T) int {
+	return BinarySearchUpperBoundBy(arr, target, identity[T])
+}
+
+// BinarySearchUpperBoundBy returns the index of the first element whose key
+// is > target.
+func BinarySearchUpperBoundBy[T any, K cmp.Ordered](arr []T, target K, key func(T) K) int {
+	low := 0
+	high := len(arr)
+
+	for low < high {
+		mid := low + (high-low)/2
+
+		if !cmp.Less(target, key(arr[mid])) {
+			low = mid + 1
+		} else {
+			high = mid
+		}
+	}
+
+	return low
+}
+
+// BinarySearchInfinite is binary search on an infinite array (or one of
+// unknown size).
+func BinarySearchInfinite[T cmp.Ordered](arr []T, target T) int {
+	return BinarySearchInfiniteBy(arr, target, identity[T])
+}
+
+// BinarySearchInfiniteBy is BinarySearchInfinite on key(arr[i]).
+func BinarySearchInfiniteBy[T any, K cmp.Ordered](arr []T, target K, key func(T) K) int {
+	// Find range where target might exist
+	low := 0
+	high := 1
+
+	// Exponentially increase high until we find a range
+	for high < len(arr) && cmp.Less(key(arr[high]), target) {
+		low = high
+		high *= 2
+	}
+
+	// Ensure high doesn't exceed array bounds
+	if high >= len(arr) {
+		high = len(arr) - 1
+	}
+
+	// Standard binary search in this range
+	return BinarySearchRecursiveBy(arr, target, low, high, key)
+}
+
+// BinarySearchRotated is binary search on a rotated sorted array.
+func BinarySearchRotated[T cmp.Ordered](arr []T, target T) int {
+	return BinarySearchRotatedBy(arr, target, identity[T])
+}
+
+// BinarySearchRotatedBy is BinarySearchRotated on key(arr[i]).
+func BinarySearchRotatedBy[T any, K cmp.Ordered](arr []T, target K, key func(T) K) int {
+	low := 0
+	high := len(arr) - 1
+
+	for low <= high {
+		mid := low + (high-low)/2
+		lowKey, midKey, highKey := key(arr[low]), key(arr[mid]), key(arr[high])
+
+		if cmp.Compare(midKey, target) == 0 {
+			return mid
+		}
+
+		// Check which half is sorted
+		if !cmp.Less(midKey, lowKey) {
+			// Left half is sorted
+			if !cmp.Less(target, lowKey) && cmp.Less(target, midKey) {
+				high = mid - 1
+			} else {
+				low = mid + 1
+			}
+		} else {
+			// Right half is sorted
+			if cmp.Less(midKey, target) && !cmp.Less(highKey, target) {
+				low = mid + 1
+			} else {
+				high = mid - 1
+			}
+		}
+	}
+
+	return -1
+}
+
+// BinarySearchPeak returns the index of a peak element.
+func BinarySearchPeak[T cmp.Ordered](arr []T) int {
+	return BinarySearchPeakBy(arr, identity[T])
+}
+
+// BinarySearchPeakBy returns the index of an element whose key is a peak.
+func BinarySearchPeakBy[T any, K cmp.Ordered](arr []T, key func(T) K) int {
+	low := 0
+	high := len(arr) - 1
+
+	for low < high {
+		mid := low + (high-low)/2
+
+		if cmp.Less(key(arr[mid]), key(arr[mid+1])) {
+			// Peak is on the right
+			low = mid + 1
+		} else {
+			// Peak is on the left or mid is peak
+			high = mid
+		}
+	}
+
+	return low
+}
+
+// BinarySearchSqrt returns the integer square root of n.
+func BinarySearchSqrt(n int) int {
+	if n < 2 {
+		return n
+	}
+
+	low := 1
+	high := n / 2
+	result := 0
+
+	for low <= high {
+		mid := low + (high-low)/2
+		square := mid * mid
+
+		if square == n {
+			return mid
+		} else if square < n {
+			result = mid
+			low = mid + 1
+		} else {
+			high = mid - 1
+		}
+	}
+
+	return result
+}
+
+// TernarySearch searches arr[low..high] by dividing it into three parts.
+func TernarySearch[T cmp.Ordered](arr []T, target T, low, high int) int {
+	return TernarySearchBy(arr, target, low, high, identity[T])
+}
+
+// TernarySearchBy is TernarySearch on key(arr[i]).
+func TernarySearchBy[T any, K cmp.Ordered](arr []T, target K, low, high int, key func(T) K) int {
+	if low > high {
+		return -1
+	}
+
+	mid1 := low + (high-low)/3
+	mid2 := high - (high-low)/3
+	key1, key2 := key(arr[mid1]), key(arr[mid2])
+
+	if cmp.Compare(key1, target) == 0 {
+		return mid1
+	}
+	if cmp.Compare(key2, target) == 0 {
+		return mid2
+	}
+
+	if cmp.Less(target, key1) {
+		return TernarySearchBy(arr, target, low, mid1-1, key)
+	} else if cmp.Less(key2, target) {
+		return TernarySearchBy(arr, target, mid2+1, high, key)
+	} else {
+		return TernarySearchBy(arr, target, mid1+1, mid2-1, key)
+	}
+}
+
+// ExponentialSearch is exponential search (for unbounded/infinite arrays).
+func ExponentialSearch[T cmp.Ordered](arr []T, target T) int {
+	return ExponentialSearchBy(arr, target, identity[T])
+}
+
+// ExponentialSearchBy is ExponentialSearch on key(arr[i]).
+func ExponentialSearchBy[T any, K cmp.Ordered](arr []T, target K, key func(T) K) int {
+	n := len(arr)
+	if n == 0 {
+		return -1
+	}
+
+	// If target is at first position
+	if cmp.Compare(key(arr[0]), target) == 0 {
+		return 0
+	}
+
+	// Find range for binary search by doubling
+	i := 1
+	for i < n && !cmp.Less(target, key(arr[i])) {
+		i *= 2
+	}
+
+	// Binary search in found range
+	low := i / 2
+	high := min(i, n-1)
+
+	return BinarySearchRecursiveBy(arr, target, low, high, key)
+}
+
+// InterpolationSearch is interpolation search (better for uniformly
+// distributed data).
+func InterpolationSearch(arr []int, target int) int {
+	low := 0
+	high := len(arr) - 1
+
+	for low <= high && target >= arr[low] && target <= arr[high] {
+		if arr[low] == arr[high] {
+			// target lies between equal bounds, so it is arr[low]
+			return low
+		}
+
+		// Probing position with interpolation, in 128-bit unsigned
+		// arithmetic so that neither the differences nor their product
+		// can overflow or lose precision. offset <= span, so the quotient
+		// is at most high-low.
+		offset := uint64(target) - uint64(arr[low])
+		span := uint64(arr[high]) - uint64(arr[low])
+		hi, lo := bits.Mul64(offset, uint64(high-low))
+		q, _ := bits.Div64(hi, lo, span)
+		pos := low + int(q)
+
+		if arr[pos] == target {
+			return pos
+		} else if arr[pos] < target {
+			low = pos + 1
+		} else {
+			high = pos - 1
+		}
+	}
+
+	return -1
+}
+
+// BinarySearch2D reports whether target is in a matrix whose rows and
+// columns are both sorted.
+func BinarySearch2D[T cmp.Ordered](matrix [][]T, target T) bool {
+	if len(matrix) == 0 || len(matrix[0]) == 0 {
+		return false
+	}
+
+	rows := len(matrix)
+	cols := len(matrix[0])
+
+	// Start from top-right corner
+	row := 0
+	col := cols - 1
+
+	for row < rows && col >= 0 {
+		if matrix[row][col] == target {
+			return true
+		} else if matrix[row][col] > target {
+			col--
+		} else {
+			row++
+		}
+	}
+
+	return false
+}
+
+// BinarySearchClosest returns the element of arr closest to target. It
+// panics if arr is empty.
+func BinarySearchClosest(arr []int, target int) int {
+	n := len(arr)
+	if n == 0 {
+		panic("searching: BinarySearchClosest of empty slice")
+	}
+
+	// Edge cases
+	if target <= arr[0] {
+		return arr[0]
+	}
+	if target >= arr[n-1] {
+		return arr[n-1]
+	}
+
+	low := 0
+	high := n - 1
+
+	for low <= high {
+		mid := low + (high-low)/2
+
+		if arr[mid] == target {
+			return arr[mid]
+		}
+
+		if arr[mid] < target {
+			// Check if target is closer to mid or mid+1
+			if mid+1 <= high && target < arr[mid+1] {
+				if target-arr[mid] < arr[mid+1]-target {
+					return arr[mid]
+				}
+				return arr[mid+1]
+			}
+			low = mid + 1
+		} else {
+			// Check if target is closer to mid or mid-1
+			if mid-1 >= low && target > arr[mid-1] {
+				if arr[mid]-target < target-arr[mid-1] {
+					return arr[mid]
+				}
+				return arr[mid-1]
+			}
+			high = mid - 1
+		}
+	}
+
+	return arr[low]
+}
+
+// BinarySearchCustom is binary search with a custom three-way comparator;
+// arr must be sorted by comp.
+func BinarySearchCustom[T any](arr []T, target T, comp comparator.Func[T]) int {
+	low := 0
+	high := len(arr) - 1
+
+	for low <= high {
+		mid := low + (high-low)/2
+		c := comp(arr[mid], target)
+
+		if c == 0 {
+			return mid
+		} else if c < 0 {
+			low = mid + 1
+		} else {
+			high = mid - 1
+		}
+	}
+
+	return -1
+}
+
+// BinarySearchObjects returns the index of a person aged targetAge in arr,
+// which must be sorted by Age.
+func BinarySearchObjects(arr []records.Person, targetAge int) int {
+	return BinarySearchIterativeBy(arr, targetAge, func(p records.Person) int {
+		return p.Age
+	})
+}
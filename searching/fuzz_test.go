@@ -0,0 +1,349 @@
+package searching_test
+
+import (
+	"cmp"
+	"encoding/binary"
+	"math"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/RaviParvadiya/learn_dsa/comparator"
+	"github.com/RaviParvadiya/learn_dsa/records"
+	"github.com/RaviParvadiya/learn_dsa/searching"
+)
+
+// ints decodes data as little-endian int16s.
+func ints(data []byte) []int {
+	arr := make([]int, len(data)/2)
+	for i := range arr {
+		arr[i] = int(int16(binary.LittleEndian.Uint16(data[2*i:])))
+	}
+	return arr
+}
+
+// sortedInts decodes data as little-endian int16s and returns them sorted.
+func sortedInts(data []byte) []int {
+	arr := ints(data)
+	slices.Sort(arr)
+	return arr
+}
+
+// offset adds base to every value of arr, which the int16s leave within
+// reach of it either way, keeping arr sorted by clamping base away from the
+// ends of the int range. It returns the clamped base.
+func offset(arr []int, base int64) int {
+	b := int(min(max(base, math.MinInt64-math.MinInt16), math.MaxInt64-math.MaxInt16))
+	for i := range arr {
+		arr[i] += b
+	}
+	return b
+}
+
+// people returns a person aged v for each v in ages, named after its index.
+func people(ages []int) []records.Person {
+	arr := make([]records.Person, len(ages))
+	for i, v := range ages {
+		arr[i] = records.Person{Name: strconv.Itoa(i), Age: v}
+	}
+	return arr
+}
+
+func age(p records.Person) int { return p.Age }
+
+// checkFound fails t unless i is an index of target in arr, or -1 exactly
+// when arr holds no target.
+func checkFound[T comparable](t *testing.T, name string, arr []T, target T, i int) {
+	t.Helper()
+	if i == -1 {
+		if slices.Contains(arr, target) {
+			t.Errorf("%s(%v, %v) = -1, but target is present", name, arr, target)
+		}
+		return
+	}
+	if i < 0 || i >= len(arr) || arr[i] != target {
+		t.Errorf("%s(%v, %v) = %d, not an index of target", name, arr, target, i)
+	}
+}
+
+// checkSame fails t unless the By variant of a search returned what the
+// plain one did.
+func checkSame[R comparable](t *testing.T, name string, got, want R) {
+	t.Helper()
+	if got != want {
+		t.Errorf("%s = %v, but the search without a key gives %v", name, got, want)
+	}
+}
+
+// FuzzBinarySearch checks the searches of a sorted slice against a linear
+// scan.
+func FuzzBinarySearch(f *testing.F) {
+	f.Add([]byte{}, int16(0), int64(0))
+	f.Add([]byte{1, 0}, int16(1), int64(0))
+	f.Add([]byte{1, 0, 2, 0, 2, 0, 3, 0}, int16(2), int64(0))
+	f.Add([]byte{0xff, 0xff, 0, 0, 5, 0}, int16(4), int64(0))
+	f.Add([]byte{0, 0, 1, 0}, int16(1), int64(1<<62))
+	f.Add([]byte{0, 0, 7, 0, 9, 0}, int16(9), int64(math.MinInt64))
+	f.Fuzz(func(t *testing.T, data []byte, target16 int16, base int64) {
+		// Values far from zero but close together are where float64
+		// runs out of precision.
+		arr := sortedInts(data)
+		target := int(target16) + offset(arr, base)
+
+		checkFound(t, "BinarySearchIterative", arr, target, searching.BinarySearchIterative(arr, target))
+		checkFound(t, "BinarySearchRecursive", arr, target, searching.BinarySearchRecursive(arr, target, 0, len(arr)-1))
+		checkFound(t, "BinarySearchInfinite", arr, target, searching.BinarySearchInfinite(arr, target))
+		checkFound(t, "TernarySearch", arr, target, searching.TernarySearch(arr, target, 0, len(arr)-1))
+		checkFound(t, "ExponentialSearch", arr, target, searching.ExponentialSearch(arr, target))
+		checkFound(t, "InterpolationSearch", arr, target, searching.InterpolationSearch(arr, target))
+		checkFound(t, "LinearSearchSorted", arr, target, searching.LinearSearchSorted(arr, target))
+
+		first := slices.Index(arr, target)
+		last := -1
+		if first >= 0 {
+			last = first
+			for last+1 < len(arr) && arr[last+1] == target {
+				last++
+			}
+		}
+		if got := searching.BinarySearchFirst(arr, target); got != first {
+			t.Errorf("BinarySearchFirst(%v, %d) = %d, want %d", arr, target, got, first)
+		}
+		if got := searching.BinarySearchLast(arr, target); got != last {
+			t.Errorf("BinarySearchLast(%v, %d) = %d, want %d", arr, target, got, last)
+		}
+		lower, _ := slices.BinarySearch(arr, target)
+		upper, _ := slices.BinarySearch(arr, target+1)
+		if got := searching.BinarySearchLowerBound(arr, target); got != lower {
+			t.Errorf("BinarySearchLowerBound(%v, %d) = %d, want %d", arr, target, got, lower)
+		}
+		if got := searching.BinarySearchUpperBound(arr, target); got != upper {
+			t.Errorf("BinarySearchUpperBound(%v, %d) = %d, want %d", arr, target, got, upper)
+		}
+		if got := searching.BinarySearchCount(arr, target); got != upper-lower {
+			t.Errorf("BinarySearchCount(%v, %d) = %d, want %d", arr, target, got, upper-lower)
+		}
+	})
+}
+
+// FuzzBinarySearchRotated checks BinarySearchRotated on rotations of sorted
+// slices of distinct values.
+func FuzzBinarySearchRotated(f *testing.F) {
+	f.Add([]byte{}, uint8(0), int16(0))
+	f.Add([]byte{1, 0, 2, 0, 3, 0, 4, 0, 5, 0}, uint8(2), int16(1))
+	f.Add([]byte{1, 0, 2, 0}, uint8(1), int16(3))
+	f.Fuzz(func(t *testing.T, data []byte, rot uint8, target16 int16) {
+		arr := slices.Compact(sortedInts(data))
+		if len(arr) > 0 {
+			k := int(rot) % len(arr)
+			arr = append(arr[k:], arr[:k]...)
+		}
+		target := int(target16)
+		checkFound(t, "BinarySearchRotated", arr, target, searching.BinarySearchRotated(arr, target))
+	})
+}
+
+// FuzzBinarySearchClosest checks that BinarySearchClosest returns an element
+// no farther from target than any other.
+func FuzzBinarySearchClosest(f *testing.F) {
+	f.Add([]byte{1, 0}, int16(0))
+	f.Add([]byte{1, 0, 4, 0, 9, 0}, int16(5))
+	f.Add([]byte{1, 0, 4, 0, 9, 0}, int16(7))
+	f.Fuzz(func(t *testing.T, data []byte, target16 int16) {
+		arr := sortedInts(data)
+		if len(arr) == 0 {
+			return
+		}
+		target := int(target16)
+		got := searching.BinarySearchClosest(arr, target)
+		if !slices.Contains(arr, got) {
+			t.Fatalf("BinarySearchClosest(%v, %d) = %d, not an element", arr, target, got)
+		}
+		for _, v := range arr {
+			if abs(v-target) < abs(got-target) {
+				t.Fatalf("BinarySearchClosest(%v, %d) = %d, but %d is closer", arr, target, got, v)
+			}
+		}
+	})
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// FuzzBinarySearchBy checks the key-extracting and comparator variants of
+// the searches of a sorted slice against the plain searches of the keys.
+func FuzzBinarySearchBy(f *testing.F) {
+	f.Add([]byte{}, int16(0))
+	f.Add([]byte{1, 0, 2, 0, 2, 0, 3, 0}, int16(2))
+	f.Add([]byte{0xff, 0xff, 0, 0, 5, 0}, int16(4))
+	f.Fuzz(func(t *testing.T, data []byte, target16 int16) {
+		ages := sortedInts(data)
+		arr := people(ages)
+		target := int(target16)
+		n := len(arr)
+
+		checkSame(t, "BinarySearchIterativeBy", searching.BinarySearchIterativeBy(arr, target, age), searching.BinarySearchIterative(ages, target))
+		checkSame(t, "BinarySearchRecursiveBy", searching.BinarySearchRecursiveBy(arr, target, 0, n-1, age), searching.BinarySearchRecursive(ages, target, 0, n-1))
+		checkSame(t, "BinarySearchFirstBy", searching.BinarySearchFirstBy(arr, target, age), searching.BinarySearchFirst(ages, target))
+		checkSame(t, "BinarySearchLastBy", searching.BinarySearchLastBy(arr, target, age), searching.BinarySearchLast(ages, target))
+		checkSame(t, "BinarySearchCountBy", searching.BinarySearchCountBy(arr, target, age), searching.BinarySearchCount(ages, target))
+		checkSame(t, "BinarySearchLowerBoundBy", searching.BinarySearchLowerBoundBy(arr, target, age), searching.BinarySearchLowerBound(ages, target))
+		checkSame(t, "BinarySearchUpperBoundBy", searching.BinarySearchUpperBoundBy(arr, target, age), searching.BinarySearchUpperBound(ages, target))
+		checkSame(t, "BinarySearchInfiniteBy", searching.BinarySearchInfiniteBy(arr, target, age), searching.BinarySearchInfinite(ages, target))
+		checkSame(t, "TernarySearchBy", searching.TernarySearchBy(arr, target, 0, n-1, age), searching.TernarySearch(ages, target, 0, n-1))
+		checkSame(t, "ExponentialSearchBy", searching.ExponentialSearchBy(arr, target, age), searching.ExponentialSearch(ages, target))
+		checkSame(t, "LinearSearchSortedBy", searching.LinearSearchSortedBy(arr, target, age), searching.LinearSearchSorted(ages, target))
+		checkSame(t, "BinarySearchObjects", searching.BinarySearchObjects(arr, target), searching.BinarySearchIterative(ages, target))
+		checkSame(t, "BinarySearchPeakBy", searching.BinarySearchPeakBy(arr, age), searching.BinarySearchPeak(ages))
+
+		byAge := comparator.ByKey(age)
+		i := searching.BinarySearchCustom(arr, records.Person{Age: target}, byAge)
+		checkFound(t, "BinarySearchCustom", ages, target, i)
+	})
+}
+
+// FuzzLinearSearch checks the linear searches of an unsorted slice, and
+// their By, Custom and self-organizing variants, against slices.Index.
+func FuzzLinearSearch(f *testing.F) {
+	f.Add([]byte{}, int16(0))
+	f.Add([]byte{1, 0}, int16(1))
+	f.Add([]byte{3, 0, 1, 0, 2, 0, 1, 0}, int16(1))
+	f.Add([]byte{3, 0, 1, 0, 2, 0, 1, 0}, int16(7))
+	f.Fuzz(func(t *testing.T, data []byte, target16 int16) {
+		arr := ints(data)
+		target := int(target16)
+		first := slices.Index(arr, target)
+		var all []int
+		for i, v := range arr {
+			if v == target {
+				all = append(all, i)
+			}
+		}
+		last := -1
+		if len(all) > 0 {
+			last = all[len(all)-1]
+		}
+
+		checkLinear(t, arr, target, first, last, all)
+
+		persons := people(arr)
+		checkSame(t, "LinearSearchBasicBy", searching.LinearSearchBasicBy(persons, target, age), first)
+		checkSame(t, "LinearSearchRangeBy", searching.LinearSearchRangeBy(persons, target, age), first)
+		checkSame(t, "LinearSearchBoolBy", searching.LinearSearchBoolBy(persons, target, age), first >= 0)
+		checkSame(t, "LinearSearchReverseBy", searching.LinearSearchReverseBy(persons, target, age), last)
+		checkSame(t, "LinearSearchRecursiveBy", searching.LinearSearchRecursiveBy(persons, target, 0, age), first)
+		checkSame(t, "LinearSearchCountBy", searching.LinearSearchCountBy(persons, target, age), len(all))
+		checkSame(t, "LinearSearchObjects", searching.LinearSearchObjects(persons, target), first)
+		if got := searching.LinearSearchAllBy(persons, target, age); !slices.Equal(got, all) {
+			t.Errorf("LinearSearchAllBy = %v, want %v", got, all)
+		}
+		checkFound(t, "LinearSearchBidirectionalBy", arr, target, searching.LinearSearchBidirectionalBy(persons, target, age))
+
+		checkSame(t, "LinearSearchCustom", searching.LinearSearchCustom(arr, target, comparator.Func[int](cmp.Compare[int]).Equal), first)
+		checkSame(t, "LinearSearchPredicate", searching.LinearSearchPredicate(arr, func(v int) bool { return v == target }), first)
+
+		checkSelfOrganizing(t, arr, target, first)
+	})
+}
+
+// checkLinear checks the plain linear searches of arr for target, given
+// the indices all of its occurrences.
+func checkLinear[T comparable](t *testing.T, arr []T, target T, first, last int, all []int) {
+	t.Helper()
+	checkSame(t, "LinearSearchBasic", searching.LinearSearchBasic(arr, target), first)
+	checkSame(t, "LinearSearchRange", searching.LinearSearchRange(arr, target), first)
+	checkSame(t, "LinearSearchBool", searching.LinearSearchBool(arr, target), first >= 0)
+	checkSame(t, "LinearSearchReverse", searching.LinearSearchReverse(arr, target), last)
+	checkSame(t, "LinearSearchRecursive", searching.LinearSearchRecursive(arr, target, 0), first)
+	checkSame(t, "LinearSearchCount", searching.LinearSearchCount(arr, target), len(all))
+	if got := searching.LinearSearchAll(arr, target); !slices.Equal(got, all) {
+		t.Errorf("LinearSearchAll(%v, %v) = %v, want %v", arr, target, got, all)
+	}
+	checkFound(t, "LinearSearchBidirectional", arr, target, searching.LinearSearchBidirectional(arr, target))
+
+	sentinel := slices.Clone(arr)
+	checkSame(t, "LinearSearchSentinel", searching.LinearSearchSentinel(sentinel, target), first)
+	if !slices.EqualFunc(sentinel, arr, same[T]) {
+		t.Errorf("LinearSearchSentinel(%v, %v) left arr as %v", arr, target, sentinel)
+	}
+}
+
+// same reports whether a and b are equal, or both not equal to themselves.
+func same[T comparable](a, b T) bool {
+	return a == b || a != a && b != b
+}
+
+// checkSelfOrganizing checks that the self-organizing searches find target
+// where they say, move it as documented, and keep the other elements.
+func checkSelfOrganizing(t *testing.T, arr []int, target, first int) {
+	t.Helper()
+	want := -1
+	if first >= 0 {
+		want = max(first-1, 0)
+	}
+	tr := slices.Clone(arr)
+	checkSame(t, "LinearSearchTransposition", searching.LinearSearchTransposition(tr, target), want)
+	checkPermuted(t, "LinearSearchTransposition", arr, tr)
+
+	if first >= 0 {
+		want = 0
+	}
+	mtf := slices.Clone(arr)
+	checkSame(t, "LinearSearchMoveToFront", searching.LinearSearchMoveToFront(mtf, target), want)
+	checkPermuted(t, "LinearSearchMoveToFront", arr, mtf)
+
+	freq := make([]searching.FreqElement[int], len(arr))
+	for i, v := range arr {
+		freq[i] = searching.FreqElement[int]{Value: v}
+	}
+	checkSame(t, "LinearSearchFrequency", searching.LinearSearchFrequency(freq, target), want)
+	if first >= 0 && (freq[0].Value != target || freq[0].Freq != 1) {
+		t.Errorf("LinearSearchFrequency(%v, %d) left %+v in front", arr, target, freq[0])
+	}
+}
+
+func checkPermuted(t *testing.T, name string, before, after []int) {
+	t.Helper()
+	a, b := slices.Clone(before), slices.Clone(after)
+	slices.Sort(a)
+	slices.Sort(b)
+	if !slices.Equal(a, b) {
+		t.Errorf("%s turned %v into %v", name, before, after)
+	}
+}
+
+// floats is the alphabet FuzzLinearSearchFloat draws values from: a NaN,
+// which equals nothing, and -0, which equals 0, among ordinary values.
+var floats = []float64{math.NaN(), math.Inf(-1), -1, math.Copysign(0, -1), 0, 0.5, 1, math.Inf(1)}
+
+// FuzzLinearSearchFloat checks the linear searches on floats, where == is
+// not an equivalence: a NaN target is found nowhere.
+func FuzzLinearSearchFloat(f *testing.F) {
+	f.Add([]byte{}, uint8(0))
+	f.Add([]byte{1, 2, 6}, uint8(0))
+	f.Add([]byte{0, 4, 0}, uint8(3))
+	f.Add([]byte{0, 0, 0}, uint8(0))
+	f.Fuzz(func(t *testing.T, data []byte, target8 uint8) {
+		arr := make([]float64, len(data))
+		for i, b := range data {
+			arr[i] = floats[int(b)%len(floats)]
+		}
+		target := floats[int(target8)%len(floats)]
+		first := slices.Index(arr, target)
+		var all []int
+		for i, v := range arr {
+			if v == target {
+				all = append(all, i)
+			}
+		}
+		last := -1
+		if len(all) > 0 {
+			last = all[len(all)-1]
+		}
+		checkLinear(t, arr, target, first, last, all)
+	})
+}
@@ -0,0 +1,304 @@
+package searching
+
+import (
+	"cmp"
+
+	"github.com/RaviParvadiya/learn_dsa/records"
+)
+
+// LinearSearchBasic is the basic linear search (returns index).
+func LinearSearchBasic[T comparable](arr []T, target T) int {
+	return LinearSearchBasicBy(arr, target, identity[T])
+}
+
+// LinearSearchBasicBy is LinearSearchBasic on key(arr[i]).
+func LinearSearchBasicBy[T any, K comparable](arr []T, target K, key func(T) K) int {
+	for i := 0; i < len(arr); i++ {
+		if key(arr[i]) == target {
+			return i
+		}
+	}
+	return -1
+}
+
+// LinearSearchRange is linear search with a range-based for loop.
+func LinearSearchRange[T comparable](arr []T, target T) int {
+	return LinearSearchRangeBy(arr, target, identity[T])
+}
+
+// LinearSearchRangeBy is LinearSearchRange on key(arr[i]).
+func LinearSearchRangeBy[T any, K comparable](arr []T, target K, key func(T) K) int {
+	for i, v := range arr {
+		if key(v) == target {
+			return i
+		}
+	}
+	return -1
+}
+
+// LinearSearchBool reports whether target is in arr.
+func LinearSearchBool[T comparable](arr []T, target T) bool {
+	return LinearSearchBoolBy(arr, target, identity[T])
+}
+
+// LinearSearchBoolBy is LinearSearchBool on key(arr[i]).
+func LinearSearchBoolBy[T any, K comparable](arr []T, target K, key func(T) K) bool {
+	for i := 0; i < len(arr); i++ {
+		if key(arr[i]) == target {
+			return true
+		}
+	}
+	return false
+}
+
+// LinearSearchReverse is linear search from the end (reverse direction).
+func LinearSearchReverse[T comparable](arr []T, target T) int {
+	return LinearSearchReverseBy(arr, target, identity[T])
+}
+
+// LinearSearchReverseBy is LinearSearchReverse on key(arr[i]).
+func LinearSearchReverseBy[T any, K comparable](arr []T, target K, key func(T) K) int {
+	for i := len(arr) - 1; i >= 0; i-- {
+		if key(arr[i]) == target {
+			return i
+		}
+	}
+	return -1
+}
+
+// LinearSearchRecursive is recursive linear search starting at index.
+func LinearSearchRecursive[T comparable](arr []T, target T, index int) int {
+	return LinearSearchRecursiveBy(arr, target, index, identity[T])
+}
+
+// LinearSearchRecursiveBy is LinearSearchRecursive on key(arr[i]).
+func LinearSearchRecursiveBy[T any, K comparable](arr []T, target K, index int, key func(T) K) int {
+	// Base case: reached end
+	if index >= len(arr) {
+		return -1
+	}
+
+	// Found target
+	if key(arr[index]) == target {
+		return index
+	}
+
+	// Recurse for next element
+	return LinearSearchRecursiveBy(arr, target, index+1, key)
+}
+
+// LinearSearchAll returns the indices of all occurrences of target.
+func LinearSearchAll[T comparable](arr []T, target T) []int {
+	return LinearSearchAllBy(arr, target, identity[T])
+}
+
+// LinearSearchAllBy is LinearSearchAll on key(arr[i]).
+func LinearSearchAllBy[T any, K comparable](arr []T, target K, key func(T) K) []int {
+	indices := []int{}
+	for i := 0; i < len(arr); i++ {
+		if key(arr[i]) == target {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+// LinearSearchCount returns the number of occurrences of target.
+func LinearSearchCount[T comparable](arr []T, target T) int {
+	return LinearSearchCountBy(arr, target, identity[T])
+}
+
+// LinearSearchCountBy is LinearSearchCount on key(arr[i]).
+func LinearSearchCountBy[T any, K comparable](arr []T, target K, key func(T) K) int {
+	count := 0
+	for i := 0; i < len(arr); i++ {
+		if key(arr[i]) == target {
+			count++
+		}
+	}
+	return count
+}
+
+// LinearSearchSentinel is sentinel linear search (optimized - avoids the
+// bound check). It temporarily overwrites the last element of arr.
+func LinearSearchSentinel[T comparable](arr []T, target T) int {
+	n := len(arr)
+
+	// A target not equal to itself, such as a NaN, would not stop the scan
+	// at the sentinel; it is equal to no element either.
+	if n == 0 || target != target {
+		return -1
+	}
+
+	// Save last element, and restore it however the scan ends
+	last := arr[n-1]
+	defer func() { arr[n-1] = last }()
+
+	// Put target as sentinel at end
+	arr[n-1] = target
+
+	i := 0
+	// No need to check i < n because sentinel guarantees we'll find it
+	for arr[i] != target {
+		i++
+	}
+
+	// Check if we found target before sentinel or at last position
+	if i < n-1 || last == target {
+		return i
+	}
+
+	return -1
+}
+
+// LinearSearchBidirectional is linear search from both ends.
+func LinearSearchBidirectional[T comparable](arr []T, target T) int {
+	return LinearSearchBidirectionalBy(arr, target, identity[T])
+}
+
+// LinearSearchBidirectionalBy is LinearSearchBidirectional on key(arr[i]).
+func LinearSearchBidirectionalBy[T any, K comparable](arr []T, target K, key func(T) K) int {
+	left := 0
+	right := len(arr) - 1
+
+	for left <= right {
+		if key(arr[left]) == target {
+			return left
+		}
+		if key(arr[right]) == target {
+			return right
+		}
+		left++
+		right--
+	}
+
+	return -1
+}
+
+// LinearSearchSorted is linear search with early termination (for sorted
+// arrays).
+func LinearSearchSorted[T cmp.Ordered](arr []T, target T) int {
+	return LinearSearchSortedBy(arr, target, identity[T])
+}
+
+// LinearSearchSortedBy is LinearSearchSorted on key(arr[i]).
+func LinearSearchSortedBy[T any, K cmp.Ordered](arr []T, target K, key func(T) K) int {
+	for i := 0; i < len(arr); i++ {
+		if key(arr[i]) == target {
+			return i
+		}
+		// If current element is greater than target, target doesn't exist
+		if cmp.Less(target, key(arr[i])) {
+			return -1
+		}
+	}
+	return -1
+}
+
+// EqualFunc reports whether a matches b. The Equal method of a
+// comparator.Func has this shape.
+type EqualFunc[T any] func(a, b T) bool
+
+// LinearSearchCustom is linear search with a custom equality comparator.
+func LinearSearchCustom[T any](arr []T, target T, comp EqualFunc[T]) int {
+	for i := 0; i < len(arr); i++ {
+		if comp(arr[i], target) {
+			return i
+		}
+	}
+	return -1
+}
+
+// LinearSearchObjects returns the index of the first person aged targetAge.
+func LinearSearchObjects(arr []records.Person, targetAge int) int {
+	return LinearSearchBasicBy(arr, targetAge, func(p records.Person) int {
+		return p.Age
+	})
+}
+
+// Predicate reports whether an element is the one being searched for.
+type Predicate[T any] func(T) bool
+
+// LinearSearchPredicate returns the index of the first element satisfying
+// pred.
+func LinearSearchPredicate[T any](arr []T, pred Predicate[T]) int {
+	for i := 0; i < len(arr); i++ {
+		if pred(arr[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// LinearSearchTransposition is self-organizing linear search that swaps the
+// found element one step towards the front.
+func LinearSearchTransposition[T comparable](arr []T, target T) int {
+	return LinearSearchTranspositionBy(arr, target, identity[T])
+}
+
+// LinearSearchTranspositionBy is LinearSearchTransposition on key(arr[i]).
+func LinearSearchTranspositionBy[T any, K comparable](arr []T, target K, key func(T) K) int {
+	for i := 0; i < len(arr); i++ {
+		if key(arr[i]) == target {
+			// Move to front if not already there
+			if i > 0 {
+				arr[i], arr[i-1] = arr[i-1], arr[i]
+				return i - 1
+			}
+			return i
+		}
+	}
+	return -1
+}
+
+// LinearSearchMoveToFront is self-organizing linear search that moves the
+// found element to the front (aggressive).
+func LinearSearchMoveToFront[T comparable](arr []T, target T) int {
+	return LinearSearchMoveToFrontBy(arr, target, identity[T])
+}
+
+// LinearSearchMoveToFrontBy is LinearSearchMoveToFront on key(arr[i]).
+func LinearSearchMoveToFrontBy[T any, K comparable](arr []T, target K, key func(T) K) int {
+	for i := 0; i < len(arr); i++ {
+		if key(arr[i]) == target {
+			// Move to front
+			if i > 0 {
+				val := arr[i]
+				// Shift elements
+				for j := i; j > 0; j-- {
+					arr[j] = arr[j-1]
+				}
+				arr[0] = val
+				return 0
+			}
+			return i
+		}
+	}
+	return -1
+}
+
+// FreqElement is a value with its access count, for LinearSearchFrequency.
+type FreqElement[T comparable] struct {
+	Value T
+	Freq  int
+}
+
+// LinearSearchFrequency is self-organizing linear search that keeps arr
+// ordered by access frequency.
+func LinearSearchFrequency[T comparable](arr []FreqElement[T], target T) int {
+	for i := 0; i < len(arr); i++ {
+		if arr[i].Value == target {
+			arr[i].Freq++
+
+			// Bubble up based on frequency
+			j := i
+			for j > 0 && arr[j].Freq > arr[j-1].Freq {
+				arr[j], arr[j-1] = arr[j-1], arr[j]
+				j--
+			}
+
+			return j
+		}
+	}
+	return -1
+}
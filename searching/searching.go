@@ -0,0 +1,14 @@
+// Package searching implements the binary and linear search variants.
+//
+// Unless noted otherwise, functions return the index of the match or -1 if
+// there is none. The binary searches require arr to be sorted ascending.
+//
+// Searches are generic over cmp.Ordered (binary) or comparable (linear)
+// element types. Each also has an XxxBy form that compares key(arr[i])
+// against the target instead, so records can be searched by any field; for
+// the binary searches arr must then be sorted by that key.
+package searching
+
+func identity[T any](v T) T {
+	return v
+}
@@ -0,0 +1,138 @@
+package sorting
+
+import (
+	"cmp"
+	"fmt"
+)
+
+// The argsorts leave their input alone and return the permutation that
+// sorts it: the indices of arr in sorted order, so that arr[perm[0]],
+// arr[perm[1]], ... is sorted. A permutation can then be applied to arr and
+// to any number of parallel slices with ApplyPermutation, moving each
+// element once, or used to read them in order without moving anything.
+
+// ArgsortMerge returns the permutation that sorts arr, found by the basic
+// top-down merge sort of MergeSortBasic. It is stable: equal elements keep
+// the order of their indices.
+func ArgsortMerge[T cmp.Ordered](arr []T) []int {
+	return ArgsortMergeFunc(arr, cmp.Less[T])
+}
+
+// ArgsortMergeFunc is ArgsortMerge ordered by less.
+func ArgsortMergeFunc[T any](arr []T, less func(a, b T) bool) []int {
+	return mergeSortBasic(identity(len(arr)), byIndex(arr, less), nil)
+}
+
+// ArgsortQuick returns the permutation that sorts arr, found by the
+// three-way quicksort of QuicksortThreeWay. It is not stable: the indices
+// of equal elements come in no particular order.
+func ArgsortQuick[T cmp.Ordered](arr []T) []int {
+	return ArgsortQuickFunc(arr, cmp.Less[T])
+}
+
+// ArgsortQuickFunc is ArgsortQuick ordered by less.
+func ArgsortQuickFunc[T any](arr []T, less func(a, b T) bool) []int {
+	perm := identity(len(arr))
+	quicksortThreeWay(perm, 0, len(perm)-1, byIndex(arr, less), nil)
+	return perm
+}
+
+// ArgsortCounting returns the permutation that stably sorts arr, found by
+// counting sort as in CountingSortStable. Negative numbers are allowed; as
+// with any counting sort, the range of arr should not be much wider than
+// its length, and it panics as CountingSortByKey does if it is too wide.
+func ArgsortCounting(arr []int) []int {
+	return CountingSortByKey(identity(len(arr)), func(i int) int { return arr[i] })
+}
+
+// identity returns the permutation 0, 1, ..., n-1.
+func identity(n int) []int {
+	perm := make([]int, n)
+	for i := range perm {
+		perm[i] = i
+	}
+	return perm
+}
+
+// byIndex orders indices of arr by the elements they index.
+func byIndex[T any](arr []T, less func(a, b T) bool) func(i, j int) bool {
+	return func(i, j int) bool { return less(arr[i], arr[j]) }
+}
+
+// IsPermutation reports whether perm holds each of 0, 1, ..., len(perm)-1
+// exactly once.
+func IsPermutation(perm []int) bool {
+	seen := make([]bool, len(perm))
+	for _, v := range perm {
+		if v < 0 || v >= len(perm) || seen[v] {
+			return false
+		}
+		seen[v] = true
+	}
+	return true
+}
+
+// ApplyPermutation rearranges arr in place so that the new arr[i] is the
+// old arr[perm[i]]. Applying the permutation returned by an argsort of arr
+// sorts arr. Each element is moved once, by following the cycles of perm,
+// and perm itself is not modified, so one permutation can be applied to
+// several slices concurrently. It panics if perm is not a permutation of
+// the indices of arr.
+func ApplyPermutation[T any](arr []T, perm []int) {
+	checkPermutation(perm, len(arr))
+
+	done := make([]bool, len(arr))
+	for start := range arr {
+		if done[start] {
+			continue
+		}
+		first := arr[start]
+		i := start
+		for {
+			done[i] = true
+			next := perm[i]
+			if next == start {
+				arr[i] = first
+				break
+			}
+			arr[i] = arr[next]
+			i = next
+		}
+	}
+}
+
+// InvertPermutation returns the inverse of perm, which undoes it: where
+// perm sorts arr, the inverse maps each index of arr to its rank, the
+// position it is sorted to. It panics if perm is not a permutation.
+func InvertPermutation(perm []int) []int {
+	checkPermutation(perm, len(perm))
+
+	inv := make([]int, len(perm))
+	for i, v := range perm {
+		inv[v] = i
+	}
+	return inv
+}
+
+// ComposePermutations returns the permutation that has the effect of
+// applying p and then q: r[i] = p[q[i]]. It panics if p and q are not
+// permutations of the same length.
+func ComposePermutations(p, q []int) []int {
+	checkPermutation(p, len(p))
+	checkPermutation(q, len(p))
+
+	r := make([]int, len(q))
+	for i, v := range q {
+		r[i] = p[v]
+	}
+	return r
+}
+
+func checkPermutation(perm []int, n int) {
+	if len(perm) != n {
+		panic(fmt.Sprintf("sorting: permutation of length %d applied to length %d", len(perm), n))
+	}
+	if !IsPermutation(perm) {
+		panic("sorting: not a permutation")
+	}
+}
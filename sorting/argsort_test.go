@@ -0,0 +1,201 @@
+package sorting_test
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/RaviParvadiya/learn_dsa/gen"
+	"github.com/RaviParvadiya/learn_dsa/sorting"
+)
+
+// checkArgsort fails t unless perm is a permutation that sorts input, with
+// equal elements in the order of their indices if stable.
+func checkArgsort(t *testing.T, name string, input, perm []int, stable bool) {
+	t.Helper()
+	if !sorting.IsPermutation(perm) || len(perm) != len(input) {
+		t.Errorf("%s: %v is not a permutation of the indices", name, head(perm))
+		return
+	}
+	for i := 1; i < len(perm); i++ {
+		a, b := input[perm[i-1]], input[perm[i]]
+		if a > b || stable && a == b && perm[i-1] > perm[i] {
+			t.Errorf("%s: indices %d and %d out of order at %d", name, perm[i-1], perm[i], i)
+			return
+		}
+	}
+}
+
+func TestArgsort(t *testing.T) {
+	rng := rand.New(rand.NewSource(12))
+	for _, d := range gen.Distributions() {
+		for _, n := range []int{0, 1, 2, 10, 1000} {
+			input := d.Generate(n, rng)
+			for i := range input {
+				input[i] -= n / 2
+			}
+			orig := slices.Clone(input)
+			name := fmt.Sprintf("%s/%d", d.Name, n)
+			checkArgsort(t, name+"/merge", input, sorting.ArgsortMerge(input), true)
+			checkArgsort(t, name+"/quick", input, sorting.ArgsortQuick(input), false)
+			checkArgsort(t, name+"/counting", input, sorting.ArgsortCounting(input), true)
+			desc := sorting.ArgsortMergeFunc(input, func(a, b int) bool { return a > b })
+			slices.Reverse(desc)
+			checkArgsort(t, name+"/merge func", input, desc, false)
+			if !slices.Equal(input, orig) {
+				t.Fatalf("%s: argsort modified its input", name)
+			}
+		}
+	}
+
+	words := []string{"pear", "fig", "apple", "fig", "date"}
+	byLen := sorting.ArgsortQuickFunc(words, func(a, b string) bool { return len(a) < len(b) })
+	lengths := make([]int, len(words))
+	for i, w := range words {
+		lengths[i] = len(w)
+	}
+	checkArgsort(t, "words by length", lengths, byLen, false)
+	if got, want := sorting.ArgsortMerge(words), []int{2, 4, 1, 3, 0}; !slices.Equal(got, want) {
+		t.Errorf("ArgsortMerge(%q) = %v, want %v", words, got, want)
+	}
+}
+
+func TestApplyPermutation(t *testing.T) {
+	arr := []string{"a", "b", "c", "d", "e"}
+	sorting.ApplyPermutation(arr, []int{2, 0, 4, 1, 3})
+	if want := []string{"c", "a", "e", "b", "d"}; !slices.Equal(arr, want) {
+		t.Errorf("got %q, want %q", arr, want)
+	}
+
+	rng := rand.New(rand.NewSource(13))
+	for _, n := range []int{0, 1, 2, 100} {
+		keys := rng.Perm(n)
+		for i := range keys {
+			keys[i] %= 7
+		}
+		names := make([]string, n)
+		for i, k := range keys {
+			names[i] = fmt.Sprint(k, "/", i)
+		}
+		perm := sorting.ArgsortMerge(keys)
+		orig := slices.Clone(perm)
+
+		// One permutation sorts both parallel slices.
+		sorting.ApplyPermutation(keys, perm)
+		sorting.ApplyPermutation(names, perm)
+		if !slices.IsSorted(keys) {
+			t.Errorf("%d: keys not sorted: %v", n, head(keys))
+		}
+		for i, k := range keys {
+			if !strings.HasPrefix(names[i], fmt.Sprint(k, "/")) {
+				t.Errorf("%d: %q moved apart from key %d", n, names[i], k)
+				break
+			}
+		}
+		if !slices.Equal(perm, orig) {
+			t.Errorf("%d: ApplyPermutation modified perm", n)
+		}
+	}
+}
+
+func TestInvertPermutation(t *testing.T) {
+	perm := []int{2, 0, 4, 1, 3}
+	inv := sorting.InvertPermutation(perm)
+	if want := []int{1, 3, 0, 4, 2}; !slices.Equal(inv, want) {
+		t.Errorf("InvertPermutation(%v) = %v, want %v", perm, inv, want)
+	}
+
+	// The inverse of the sorting permutation gives the rank of each
+	// element.
+	arr := []int{30, 10, 20, 40}
+	if ranks, want := sorting.InvertPermutation(sorting.ArgsortMerge(arr)), []int{2, 0, 1, 3}; !slices.Equal(ranks, want) {
+		t.Errorf("ranks of %v = %v, want %v", arr, ranks, want)
+	}
+
+	rng := rand.New(rand.NewSource(14))
+	for _, n := range []int{0, 1, 50} {
+		p := rng.Perm(n)
+		inv := sorting.InvertPermutation(p)
+		if id := sorting.ComposePermutations(p, inv); !slices.IsSorted(id) || !sorting.IsPermutation(id) {
+			t.Errorf("%v composed with its inverse %v is %v", p, inv, id)
+		}
+		if id := sorting.ComposePermutations(inv, p); !slices.IsSorted(id) || !sorting.IsPermutation(id) {
+			t.Errorf("inverse %v composed with %v is %v", inv, p, id)
+		}
+	}
+}
+
+// TestComposePermutations pins down r[i] = p[q[i]], and that applying r is
+// applying p and then q.
+func TestComposePermutations(t *testing.T) {
+	p := []int{1, 2, 0}
+	q := []int{0, 2, 1}
+	if r, want := sorting.ComposePermutations(p, q), []int{1, 0, 2}; !slices.Equal(r, want) {
+		t.Errorf("ComposePermutations(%v, %v) = %v, want %v", p, q, r, want)
+	}
+
+	rng := rand.New(rand.NewSource(15))
+	for _, n := range []int{0, 1, 2, 30} {
+		p, q := rng.Perm(n), rng.Perm(n)
+		arr := rng.Perm(n)
+		twice := slices.Clone(arr)
+		sorting.ApplyPermutation(twice, p)
+		sorting.ApplyPermutation(twice, q)
+		once := slices.Clone(arr)
+		sorting.ApplyPermutation(once, sorting.ComposePermutations(p, q))
+		if !slices.Equal(once, twice) {
+			t.Errorf("applying p=%v then q=%v gives %v, their composition %v", p, q, twice, once)
+		}
+	}
+}
+
+func TestIsPermutation(t *testing.T) {
+	tests := []struct {
+		perm []int
+		want bool
+	}{
+		{nil, true},
+		{[]int{0}, true},
+		{[]int{1, 0, 2}, true},
+		{[]int{1}, false},
+		{[]int{0, 0}, false},
+		{[]int{0, -1}, false},
+		{[]int{0, 2}, false},
+	}
+	for _, tt := range tests {
+		if got := sorting.IsPermutation(tt.perm); got != tt.want {
+			t.Errorf("IsPermutation(%v) = %t, want %t", tt.perm, got, tt.want)
+		}
+	}
+}
+
+// TestPermutationPanics checks that every function taking a permutation
+// rejects a wrong length or a non-permutation with a message of its own.
+func TestPermutationPanics(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func()
+		msg  string
+	}{
+		{"apply short", func() { sorting.ApplyPermutation([]int{1, 2, 3}, []int{0, 1}) }, "sorting: permutation of length 2 applied to length 3"},
+		{"apply duplicate", func() { sorting.ApplyPermutation([]int{1, 2}, []int{1, 1}) }, "sorting: not a permutation"},
+		{"apply out of range", func() { sorting.ApplyPermutation([]int{1, 2}, []int{0, 2}) }, "sorting: not a permutation"},
+		{"apply negative", func() { sorting.ApplyPermutation([]int{1, 2}, []int{-1, 0}) }, "sorting: not a permutation"},
+		{"invert", func() { sorting.InvertPermutation([]int{2, 0}) }, "sorting: not a permutation"},
+		{"compose lengths", func() { sorting.ComposePermutations([]int{0, 1}, []int{0}) }, "sorting: permutation of length 1 applied to length 2"},
+		{"compose p", func() { sorting.ComposePermutations([]int{0, 0}, []int{0, 1}) }, "sorting: not a permutation"},
+		{"compose q", func() { sorting.ComposePermutations([]int{0, 1}, []int{1, 1}) }, "sorting: not a permutation"},
+	}
+	for _, tt := range tests {
+		func() {
+			defer func() {
+				if msg, _ := recover().(string); msg != tt.msg {
+					t.Errorf("%s: got panic %q, want %q", tt.name, msg, tt.msg)
+				}
+			}()
+			tt.f()
+		}()
+	}
+}
@@ -0,0 +1,279 @@
+package sorting
+
+import (
+	"cmp"
+
+	"github.com/RaviParvadiya/learn_dsa/instrument"
+)
+
+const (
+	// blockMergeRun is the length of the runs BlockMergeSort insertion
+	// sorts before it starts merging, and its smallest block length.
+	blockMergeRun = 16
+
+	// blockMergeMin is the length below which BlockMergeSort only
+	// insertion sorts.
+	blockMergeMin = 64
+)
+
+// BlockMergeSort is a stable merge sort that needs no memory beyond a few
+// variables, in the manner of GrailSort. It first moves about 2√n distinct
+// values to the front of arr, to serve as an internal buffer of b ≈ √n
+// elements and as tags marking blocks. Runs are then merged bottom-up
+// through the buffer, swapping elements into place instead of copying
+// them. Runs longer than the buffer are merged a block of b elements at a
+// time: the blocks of both runs are selection sorted by their first
+// elements, ties broken by their tags so that equal elements keep their
+// order, and each block is then merged with what is left of the blocks
+// before it. The buffer and the tags are finally sorted and merged back
+// with SymMerge. It makes O(n log n) comparisons and swaps.
+//
+// If arr holds too few distinct values for the buffer, BlockMergeSort
+// merges with SymMerge instead, making O(n log² n) swaps.
+func BlockMergeSort[T cmp.Ordered](arr []T) {
+	blockMergeSort(arr, cmp.Less[T], nil)
+}
+
+// BlockMergeSortFunc is BlockMergeSort ordered by less.
+func BlockMergeSortFunc[T any](arr []T, less func(a, b T) bool) {
+	blockMergeSort(arr, less, nil)
+}
+
+func blockMergeSort[T any](arr []T, less func(a, b T) bool, p *instrument.Probe) {
+	n := len(arr)
+	if n < blockMergeMin {
+		insertionSort(arr, 0, n-1, less, p)
+		return
+	}
+
+	// The buffer holds one block, and there is a tag for each block a
+	// merge can span.
+	bs := blockMergeRun
+	for bs*bs < n {
+		bs *= 2
+	}
+	tags := n / bs
+	keys := tags + bs
+	if collectKeys(arr, keys, less, p) < keys {
+		symMergeSort(arr, less, p)
+		return
+	}
+
+	// arr is now tags, buffer, then the data to sort.
+	buf := tags
+	for a := keys; a < n; a += blockMergeRun {
+		insertionSort(arr, a, min(a+blockMergeRun, n)-1, less, p)
+	}
+	for w := blockMergeRun; w < n-keys; w *= 2 {
+		// b is where the buffer is; it travels to the end of the data,
+		// leaving merged pairs of runs behind it, and is then rotated
+		// back for the next pass.
+		b := buf
+		for b+bs < n {
+			x0 := b + bs
+			x1 := min(x0+w, n)
+			y1 := min(x1+w, n)
+			p.Merge(x0, x1-1, y1-1)
+			if x1 < y1 {
+				p.Compare(x1, x1-1)
+				if less(arr[x1], arr[x1-1]) {
+					if w <= bs {
+						b, _ = bufferMerge(arr, b, bs, x1, y1, true, less, p)
+					} else {
+						b = blockMerge(arr, 0, b, bs, x1, y1, less, p)
+					}
+				}
+			}
+			b = emit(arr, b, bs, y1, p)
+		}
+		if b > buf {
+			rotateRange(arr, buf, b, b+bs, p)
+		}
+	}
+
+	// The tags and the buffer hold distinct values, so any sort restores
+	// their order, and as the first occurrences of their values they go
+	// before the equal elements of the data.
+	insertionSort(arr, 0, keys-1, less, p)
+	symMerge(arr, 0, keys, n, less, p)
+}
+
+// collectKeys moves the first occurrences of up to want distinct values of
+// arr to its front, in ascending order, keeping the other elements in
+// order behind them. It returns how many it moved.
+func collectKeys[T any](arr []T, want int, less func(a, b T) bool, p *instrument.Probe) int {
+	// The keys found so far are arr[start:start+found], kept sorted and
+	// moved along behind the scan.
+	start, found := 0, 1
+	for i := 1; i < len(arr) && found < want; i++ {
+		lo, hi := start, start+found
+		for lo < hi {
+			h := int(uint(lo+hi) >> 1)
+			p.Compare(h, i)
+			if less(arr[h], arr[i]) {
+				lo = h + 1
+			} else {
+				hi = h
+			}
+		}
+		if lo < start+found {
+			p.Compare(i, lo)
+			if !less(arr[i], arr[lo]) {
+				continue // arr[i] equals a key
+			}
+		}
+
+		at := lo - start
+		if start+found < i {
+			rotateRange(arr, start, start+found, i, p)
+		}
+		start = i - found
+		if at < found {
+			rotateRange(arr, start+at, i, i+1, p)
+		}
+		found++
+	}
+	if start > 0 {
+		rotateRange(arr, 0, start, start+found, p)
+	}
+	return found
+}
+
+// bufferMerge merges the sorted runs X = arr[x0:x1] and Y = arr[x1:y1]
+// through the buffer arr[buf:x0] of n elements, x0 being buf+n, by
+// swapping each next element into the buffer's place. Y must be no longer
+// than the buffer. Ties go to X if xFirst, and otherwise to Y.
+//
+// Merging stops when either run runs out. The buffer then occupies
+// arr[at:at+n], and what is left of the other run follows it up to y1;
+// fromX reports whether that was X.
+func bufferMerge[T any](arr []T, buf, n, x1, y1 int, xFirst bool, less func(a, b T) bool, p *instrument.Probe) (at int, fromX bool) {
+	d, i, j := buf, buf+n, x1
+	for i < x1 && j < y1 {
+		p.Compare(j, i)
+		var takeY bool
+		if xFirst {
+			takeY = less(arr[j], arr[i])
+		} else {
+			takeY = !less(arr[i], arr[j])
+		}
+		if takeY {
+			arr[d], arr[j] = arr[j], arr[d]
+			p.Swap(d, j)
+			j++
+		} else {
+			arr[d], arr[i] = arr[i], arr[d]
+			p.Swap(d, i)
+			i++
+		}
+		d++
+	}
+	if i == x1 {
+		return d, false
+	}
+
+	// Move the rest of X to the end, behind the buffer.
+	for k, e := x1-1, y1-1; k >= i; k, e = k-1, e-1 {
+		arr[k], arr[e] = arr[e], arr[k]
+		p.Swap(k, e)
+	}
+	return d, true
+}
+
+// emit moves the elements between the buffer arr[buf:buf+n] and end in
+// front of it, and returns where the buffer then starts.
+func emit[T any](arr []T, buf, n, end int, p *instrument.Probe) int {
+	for k := buf; k+n < end; k++ {
+		arr[k], arr[k+n] = arr[k+n], arr[k]
+		p.Swap(k, k+n)
+	}
+	return end - n
+}
+
+// blockMerge merges the sorted runs A = arr[x0:x1] and B = arr[x1:y1]
+// through the buffer arr[buf:x0] of bs elements, x0 being buf+bs. A is a
+// whole number of blocks of bs elements, B may end in a shorter one, and
+// arr[tags:] holds a sorted tag for each whole block. It leaves the tags
+// sorted and the buffer in front of the last block it has not emitted,
+// and returns where the buffer starts.
+func blockMerge[T any](arr []T, tags, buf, bs, x1, y1 int, less func(a, b T) bool, p *instrument.Probe) int {
+	x0 := buf + bs
+	na := (x1 - x0) / bs
+	m := na + (y1-x1)/bs
+	first := func(k int) int { return x0 + k*bs }
+
+	// Blocks of A have tags below the tag of the first block of B.
+	var mid T
+	if m > na {
+		mid = arr[tags+na]
+	}
+	fromA := func(k int) bool { return m == na || less(arr[tags+k], mid) }
+
+	// Selection sort the whole blocks by their first elements, ties
+	// going to the block with the smaller tag, which keeps the blocks of
+	// each run in order and puts blocks of A before equal ones of B.
+	for i := range m {
+		lo := i
+		for j := i + 1; j < m; j++ {
+			p.Compare(first(j), first(lo))
+			if less(arr[first(j)], arr[first(lo)]) {
+				lo = j
+			} else if !less(arr[first(lo)], arr[first(j)]) {
+				p.Compare(tags+j, tags+lo)
+				if less(arr[tags+j], arr[tags+lo]) {
+					lo = j
+				}
+			}
+		}
+		if lo != i {
+			swapRange(arr, first(i), first(lo), bs, p)
+			arr[tags+i], arr[tags+lo] = arr[tags+lo], arr[tags+i]
+			p.Swap(tags+i, tags+lo)
+		}
+	}
+
+	// The short last block of B, if any, goes before the blocks starting
+	// with greater elements, which can only be blocks of A.
+	short := first(m)
+	after := m
+	for short < y1 && after > 0 {
+		p.Compare(short, first(after-1))
+		if !less(arr[short], arr[first(after-1)]) {
+			break
+		}
+		after--
+	}
+	if short < y1 && after < m {
+		rotateRange(arr, first(after), short, y1, p)
+	}
+
+	// Merge each block with what is left of the blocks before it, which
+	// is all of one run, or emit that if the block is of the same run.
+	// Everything emitted is no greater than anything still to come.
+	restEnd, restA := x0, true
+	block := func(end int, a bool) {
+		if a == restA || restEnd == buf+bs {
+			buf = emit(arr, buf, bs, restEnd, p)
+			restA = a
+		} else {
+			var fromX bool
+			buf, fromX = bufferMerge(arr, buf, bs, restEnd, end, restA, less, p)
+			if !fromX {
+				restA = a
+			}
+		}
+		restEnd = end
+	}
+	for k := range after {
+		block(first(k+1), fromA(k))
+	}
+	if short < y1 {
+		block(first(after)+y1-short, false)
+	}
+	for k := after; k < m; k++ {
+		block(first(k+1)+y1-short, fromA(k))
+	}
+
+	insertionSort(arr, tags, tags+m-1, less, p)
+	return buf
+}
@@ -0,0 +1,292 @@
+package sorting
+
+import (
+	"cmp"
+
+	"github.com/RaviParvadiya/learn_dsa/comparator"
+	"github.com/RaviParvadiya/learn_dsa/instrument"
+	"github.com/RaviParvadiya/learn_dsa/records"
+)
+
+// BubbleSortBasic is the basic bubble sort (no optimization).
+func BubbleSortBasic[T cmp.Ordered](arr []T) {
+	bubbleSortBasic(arr, cmp.Less[T], nil)
+}
+
+// BubbleSortBasicFunc is BubbleSortBasic ordered by less.
+func BubbleSortBasicFunc[T any](arr []T, less func(a, b T) bool) {
+	bubbleSortBasic(arr, less, nil)
+}
+
+func bubbleSortBasic[T any](arr []T, less func(a, b T) bool, p *instrument.Probe) {
+	n := len(arr)
+	for i := 0; i < n-1; i++ {
+		for j := 0; j < n-1-i; j++ {
+			p.Compare(j+1, j)
+			if less(arr[j+1], arr[j]) {
+				arr[j], arr[j+1] = arr[j+1], arr[j]
+				p.Swap(j, j+1)
+			}
+		}
+	}
+}
+
+// BubbleSortOptimized is bubble sort with a swap flag.
+func BubbleSortOptimized[T cmp.Ordered](arr []T) {
+	bubbleSortOptimized(arr, cmp.Less[T], nil)
+}
+
+// BubbleSortOptimizedFunc is BubbleSortOptimized ordered by less.
+func BubbleSortOptimizedFunc[T any](arr []T, less func(a, b T) bool) {
+	bubbleSortOptimized(arr, less, nil)
+}
+
+func bubbleSortOptimized[T any](arr []T, less func(a, b T) bool, p *instrument.Probe) {
+	n := len(arr)
+	for i := 0; i < n-1; i++ {
+		swapped := false
+		for j := 0; j < n-1-i; j++ {
+			p.Compare(j+1, j)
+			if less(arr[j+1], arr[j]) {
+				arr[j], arr[j+1] = arr[j+1], arr[j]
+				p.Swap(j, j+1)
+				swapped = true
+			}
+		}
+		// If no swaps occurred, array is sorted
+		if !swapped {
+			break
+		}
+	}
+}
+
+// BubbleSortRecursive sorts the first n elements of arr recursively.
+func BubbleSortRecursive[T cmp.Ordered](arr []T, n int) {
+	bubbleSortRecursive(arr, n, cmp.Less[T], nil)
+}
+
+// BubbleSortRecursiveFunc is BubbleSortRecursive ordered by less.
+func BubbleSortRecursiveFunc[T any](arr []T, n int, less func(a, b T) bool) {
+	bubbleSortRecursive(arr, n, less, nil)
+}
+
+func bubbleSortRecursive[T any](arr []T, n int, less func(a, b T) bool, p *instrument.Probe) {
+	p.Enter()
+	defer p.Leave()
+
+	// Base case
+	if n <= 1 {
+		return
+	}
+
+	// One pass of bubble sort - bubble largest to end
+	swapped := false
+	for i := 0; i < n-1; i++ {
+		p.Compare(i+1, i)
+		if less(arr[i+1], arr[i]) {
+			arr[i], arr[i+1] = arr[i+1], arr[i]
+			p.Swap(i, i+1)
+			swapped = true
+		}
+	}
+
+	// Early termination if no swaps
+	if !swapped {
+		return
+	}
+
+	// Recurse for remaining array
+	bubbleSortRecursive(arr, n-1, less, p)
+}
+
+// BubbleSortCocktail is cocktail shaker sort (bidirectional bubble sort).
+func BubbleSortCocktail[T cmp.Ordered](arr []T) {
+	bubbleSortCocktail(arr, cmp.Less[T], nil)
+}
+
+// BubbleSortCocktailFunc is BubbleSortCocktail ordered by less.
+func BubbleSortCocktailFunc[T any](arr []T, less func(a, b T) bool) {
+	bubbleSortCocktail(arr, less, nil)
+}
+
+func bubbleSortCocktail[T any](arr []T, less func(a, b T) bool, p *instrument.Probe) {
+	n := len(arr)
+	swapped := true
+	start := 0
+	end := n - 1
+
+	for swapped {
+		swapped = false
+
+		// Forward pass (like normal bubble sort)
+		for i := start; i < end; i++ {
+			p.Compare(i+1, i)
+			if less(arr[i+1], arr[i]) {
+				arr[i], arr[i+1] = arr[i+1], arr[i]
+				p.Swap(i, i+1)
+				swapped = true
+			}
+		}
+
+		// If no swaps, array is sorted
+		if !swapped {
+			break
+		}
+
+		end--
+		swapped = false
+
+		// Backward pass
+		for i := end - 1; i >= start; i-- {
+			p.Compare(i+1, i)
+			if less(arr[i+1], arr[i]) {
+				arr[i], arr[i+1] = arr[i+1], arr[i]
+				p.Swap(i, i+1)
+				swapped = true
+			}
+		}
+
+		start++
+	}
+}
+
+// BubbleSortOddEven is odd-even sort (parallel bubble sort variant).
+func BubbleSortOddEven[T cmp.Ordered](arr []T) {
+	bubbleSortOddEven(arr, cmp.Less[T], nil)
+}
+
+// BubbleSortOddEvenFunc is BubbleSortOddEven ordered by less.
+func BubbleSortOddEvenFunc[T any](arr []T, less func(a, b T) bool) {
+	bubbleSortOddEven(arr, less, nil)
+}
+
+func bubbleSortOddEven[T any](arr []T, less func(a, b T) bool, p *instrument.Probe) {
+	n := len(arr)
+	sorted := false
+
+	for !sorted {
+		sorted = true
+
+		// Odd phase
+		for i := 1; i < n-1; i += 2 {
+			p.Compare(i+1, i)
+			if less(arr[i+1], arr[i]) {
+				arr[i], arr[i+1] = arr[i+1], arr[i]
+				p.Swap(i, i+1)
+				sorted = false
+			}
+		}
+
+		// Even phase
+		for i := 0; i < n-1; i += 2 {
+			p.Compare(i+1, i)
+			if less(arr[i+1], arr[i]) {
+				arr[i], arr[i+1] = arr[i+1], arr[i]
+				p.Swap(i, i+1)
+				sorted = false
+			}
+		}
+	}
+}
+
+// BubbleSortComb is comb sort (improved bubble sort with gap).
+func BubbleSortComb[T cmp.Ordered](arr []T) {
+	bubbleSortComb(arr, cmp.Less[T], nil)
+}
+
+// BubbleSortCombFunc is BubbleSortComb ordered by less.
+func BubbleSortCombFunc[T any](arr []T, less func(a, b T) bool) {
+	bubbleSortComb(arr, less, nil)
+}
+
+func bubbleSortComb[T any](arr []T, less func(a, b T) bool, p *instrument.Probe) {
+	n := len(arr)
+	gap := n
+	shrink := 1.3
+	swapped := true
+
+	for gap > 1 || swapped {
+		// Update gap
+		gap = int(float64(gap) / shrink)
+		if gap < 1 {
+			gap = 1
+		}
+
+		swapped = false
+
+		// Compare elements gap distance apart
+		for i := 0; i+gap < n; i++ {
+			p.Compare(i+gap, i)
+			if less(arr[i+gap], arr[i]) {
+				arr[i], arr[i+gap] = arr[i+gap], arr[i]
+				p.Swap(i, i+gap)
+				swapped = true
+			}
+		}
+	}
+}
+
+// BubbleSortDescending is bubble sort in descending order.
+func BubbleSortDescending[T cmp.Ordered](arr []T) {
+	n := len(arr)
+	for i := 0; i < n-1; i++ {
+		swapped := false
+		for j := 0; j < n-1-i; j++ {
+			if arr[j] < arr[j+1] { // Changed comparison
+				arr[j], arr[j+1] = arr[j+1], arr[j]
+				swapped = true
+			}
+		}
+		if !swapped {
+			break
+		}
+	}
+}
+
+// BubbleSortCustom is bubble sort ordered by comp.
+func BubbleSortCustom[T any](arr []T, comp comparator.Func[T]) {
+	bubbleSortOptimized(arr, comp.Less, nil)
+}
+
+// BubbleSortObjects sorts students by Score.
+func BubbleSortObjects(arr []records.Student) {
+	bubbleSortOptimized(arr, func(a, b records.Student) bool {
+		return a.Score < b.Score
+	}, nil)
+}
+
+// BubbleSortLastSwap is bubble sort with the last swap optimization.
+func BubbleSortLastSwap[T cmp.Ordered](arr []T) {
+	bubbleSortLastSwap(arr, cmp.Less[T], nil)
+}
+
+// BubbleSortLastSwapFunc is BubbleSortLastSwap ordered by less.
+func BubbleSortLastSwapFunc[T any](arr []T, less func(a, b T) bool) {
+	bubbleSortLastSwap(arr, less, nil)
+}
+
+func bubbleSortLastSwap[T any](arr []T, less func(a, b T) bool, p *instrument.Probe) {
+	n := len(arr)
+
+	for n > 1 {
+		newN := 0
+		for i := 1; i < n; i++ {
+			p.Compare(i, i-1)
+			if less(arr[i], arr[i-1]) {
+				arr[i-1], arr[i] = arr[i], arr[i-1]
+				p.Swap(i-1, i)
+				newN = i // Remember last swap position
+			}
+		}
+		n = newN // All elements after last swap are sorted
+	}
+}
+
+// BubbleSortWithCount is optimized bubble sort that also reports the number
+// of comparisons and swaps it made (for educational purposes). Every
+// algorithm can report these and more through the Algorithms registry.
+func BubbleSortWithCount[T cmp.Ordered](arr []T) (comparisons, swaps int) {
+	var p instrument.Probe
+	bubbleSortOptimized(arr, cmp.Less[T], &p)
+	return p.Comparisons, p.Swaps
+}
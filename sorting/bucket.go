@@ -0,0 +1,166 @@
+package sorting
+
+import (
+	"cmp"
+
+	"github.com/RaviParvadiya/learn_dsa/instrument"
+)
+
+// BucketSortBasic is bucket sort for uniformly distributed floats in [0, 1).
+func BucketSortBasic(arr []float64) {
+	if len(arr) == 0 {
+		return
+	}
+
+	n := len(arr)
+
+	// Create n empty buckets
+	buckets := make([][]float64, n)
+	for i := range buckets {
+		buckets[i] = make([]float64, 0)
+	}
+
+	// Put elements into buckets
+	for _, v := range arr {
+		idx := int(v * float64(n))
+		if idx == n {
+			idx = n - 1
+		}
+		buckets[idx] = append(buckets[idx], v)
+	}
+
+	// Sort individual buckets using insertion sort
+	for i := range buckets {
+		insertionSortFloat(buckets[i])
+	}
+
+	// Concatenate all buckets
+	idx := 0
+	for i := range buckets {
+		for j := range buckets[i] {
+			arr[idx] = buckets[i][j]
+			idx++
+		}
+	}
+}
+
+func insertionSortFloat(arr []float64) {
+	n := len(arr)
+	for i := 1; i < n; i++ {
+		key := arr[i]
+		j := i - 1
+		for j >= 0 && arr[j] > key {
+			arr[j+1] = arr[j]
+			j--
+		}
+		arr[j+1] = key
+	}
+}
+
+// BucketSortIntegers is bucket sort for integers, using one bucket per
+// element spread over the value range.
+func BucketSortIntegers(arr []int) {
+	bucketSortIntegers(arr, nil)
+}
+
+func bucketSortIntegers(arr []int, p *instrument.Probe) {
+	if len(arr) == 0 {
+		return
+	}
+
+	// Find min and max
+	min, max := arr[0], arr[0]
+	for i, v := range arr {
+		p.Compare(i, -1)
+		if v < min {
+			min = v
+		}
+		p.Compare(i, -1)
+		if v > max {
+			max = v
+		}
+	}
+
+	// Create buckets
+	bucketCount := len(arr)
+	bucketRange := (max-min)/bucketCount + 1
+	buckets := make([][]int, bucketCount)
+
+	// Distribute into buckets
+	for _, v := range arr {
+		idx := (v - min) / bucketRange
+		if idx >= bucketCount {
+			idx = bucketCount - 1
+		}
+		buckets[idx] = append(buckets[idx], v)
+	}
+
+	// Sort each bucket
+	sub := p.Scratch()
+	for i := range buckets {
+		p.Alloc(len(buckets[i]))
+		insertionSortBasic(buckets[i], cmp.Less[int], sub)
+	}
+	p.Fold(sub)
+
+	// Concatenate
+	idx := 0
+	for i := range buckets {
+		for j := range buckets[i] {
+			arr[idx] = buckets[i][j]
+			p.Write(idx)
+			idx++
+		}
+	}
+}
+
+// BucketSortFixedBuckets is bucket sort for integers with a fixed number of
+// buckets.
+func BucketSortFixedBuckets(arr []int, numBuckets int) {
+	bucketSortFixedBuckets(arr, numBuckets, nil)
+}
+
+func bucketSortFixedBuckets(arr []int, numBuckets int, p *instrument.Probe) {
+	if len(arr) == 0 {
+		return
+	}
+
+	min, max := arr[0], arr[0]
+	for i, v := range arr {
+		p.Compare(i, -1)
+		if v < min {
+			min = v
+		}
+		p.Compare(i, -1)
+		if v > max {
+			max = v
+		}
+	}
+
+	// Create buckets
+	bucketRange := float64(max-min+1) / float64(numBuckets)
+	buckets := make([][]int, numBuckets)
+
+	// Distribute
+	for _, v := range arr {
+		idx := int(float64(v-min) / bucketRange)
+		if idx >= numBuckets {
+			idx = numBuckets - 1
+		}
+		buckets[idx] = append(buckets[idx], v)
+	}
+
+	// Sort and concatenate
+	idx := 0
+	sub := p.Scratch()
+	for i := range buckets {
+		p.Alloc(len(buckets[i]))
+		insertionSortBasic(buckets[i], cmp.Less[int], sub)
+		for j := range buckets[i] {
+			arr[idx] = buckets[i][j]
+			p.Write(idx)
+			idx++
+		}
+	}
+	p.Fold(sub)
+}
@@ -0,0 +1,167 @@
+package sorting_test
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/RaviParvadiya/learn_dsa/gen"
+	"github.com/RaviParvadiya/learn_dsa/instrument"
+	"github.com/RaviParvadiya/learn_dsa/sorting"
+)
+
+// TestConformance holds every registered variant to the properties it
+// declares, using slices.Sort as the oracle.
+func TestConformance(t *testing.T) {
+	for _, a := range sorting.Algorithms() {
+		t.Run(a.Name, func(t *testing.T) {
+			for _, c := range cases(a) {
+				got := slices.Clone(c.input)
+				a.Sort(got, nil)
+				checkSorted(t, c.name, c.input, got)
+			}
+			if a.Stable {
+				if a.SortFunc == nil {
+					t.Error("declared stable but has no SortFunc to show it")
+				} else {
+					checkStable(t, a)
+				}
+			}
+			if !a.Negatives {
+				checkRejectsNegatives(t, a)
+			}
+			if a.InPlace {
+				checkInPlace(t, a)
+			}
+		})
+	}
+}
+
+type testCase struct {
+	name  string
+	input []int
+}
+
+// cases returns the inputs a must sort: every distribution at a range of
+// sizes, shifted to include negatives and spread to large values when a
+// supports them.
+func cases(a sorting.Algorithm) []testCase {
+	cs := []testCase{
+		{"nil", nil},
+		{"empty", []int{}},
+		{"single", []int{7}},
+		{"pair", []int{2, 1}},
+		{"equal", []int{4, 4, 4, 4, 4}},
+		{"zeros", make([]int, 9)},
+	}
+	rng := rand.New(rand.NewSource(1))
+	for _, d := range gen.Distributions() {
+		for _, n := range []int{2, 3, 10, 100, 1000} {
+			input := d.Generate(n, rng)
+			cs = append(cs, testCase{fmt.Sprintf("%s/%d", d.Name, n), input})
+			if a.Negatives {
+				cs = append(cs, testCase{fmt.Sprintf("%s/%d/negative", d.Name, n), shift(input, -n/2)})
+			}
+		}
+	}
+	span := int64(1) << 40
+	if a.MaxRange > 0 {
+		span = int64(a.MaxRange)
+	}
+	for _, n := range []int{10, 1000} {
+		wide := make([]int, n)
+		for i := range wide {
+			wide[i] = int(rng.Int63n(span))
+		}
+		cs = append(cs, testCase{fmt.Sprintf("wide/%d", n), wide})
+		if a.Negatives {
+			cs = append(cs, testCase{fmt.Sprintf("wide/%d/negative", n), shift(wide, -int(span/2))})
+		}
+	}
+	return cs
+}
+
+func shift(arr []int, by int) []int {
+	out := make([]int, len(arr))
+	for i, v := range arr {
+		out[i] = v + by
+	}
+	return out
+}
+
+// checkSorted fails t unless got is input in ascending order.
+func checkSorted(t *testing.T, name string, input, got []int) {
+	t.Helper()
+	want := slices.Clone(input)
+	slices.Sort(want)
+	if len(got) != len(want) {
+		t.Errorf("%s: got %d elements, want %d", name, len(got), len(want))
+		return
+	}
+	for i := 1; i < len(got); i++ {
+		if got[i] < got[i-1] {
+			t.Errorf("%s: not sorted at %d: %d before %d", name, i, got[i-1], got[i])
+			return
+		}
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("%s: output is not a permutation of the input", name)
+	}
+}
+
+// checkStable sorts values carrying their original position below a small
+// key, comparing the keys only, and fails t if equal keys are reordered.
+func checkStable(t *testing.T, a sorting.Algorithm) {
+	t.Helper()
+	const stride = 1 << 20
+	// Random keys repeat too, but are distinct enough for the sorts that
+	// take a different path on few distinct values.
+	for _, dist := range []string{"few-unique", "random"} {
+		for _, n := range []int{10, 100, 1000} {
+			keys, _ := gen.Ints(dist, n, 2)
+			arr := make([]int, n)
+			for i, k := range keys {
+				arr[i] = k*stride + i
+			}
+			a.SortFunc(arr, func(x, y int) bool { return x/stride < y/stride }, nil)
+			for i := 1; i < n; i++ {
+				if arr[i-1]/stride == arr[i]/stride && arr[i-1] > arr[i] {
+					t.Errorf("unstable on %d %s values: positions %d and %d of key %d swapped",
+						n, dist, arr[i-1]%stride, arr[i]%stride, arr[i]/stride)
+					break
+				}
+			}
+		}
+	}
+}
+
+// checkRejectsNegatives fails t unless a, which does not declare support
+// for negatives, panics with an error of its own on an input holding one,
+// rather than misordering it or indexing out of range.
+func checkRejectsNegatives(t *testing.T, a sorting.Algorithm) {
+	t.Helper()
+	for _, input := range [][]int{{-1}, {3, -1, 2}, {5, 0, 7, -100, 1}} {
+		func() {
+			defer func() {
+				msg, _ := recover().(string)
+				if !strings.HasPrefix(msg, "sorting: ") {
+					t.Errorf("%v: got panic %q, want a sorting error", input, msg)
+				}
+			}()
+			a.Sort(slices.Clone(input), nil)
+		}()
+	}
+}
+
+// checkInPlace fails t if a allocates as many elements as it sorts.
+func checkInPlace(t *testing.T, a sorting.Algorithm) {
+	t.Helper()
+	arr, _ := gen.Ints("random", 1000, 3)
+	var p instrument.Probe
+	a.Sort(arr, &p)
+	if p.AllocElems >= len(arr) {
+		t.Errorf("declared in place but allocated %d elements sorting %d", p.AllocElems, len(arr))
+	}
+}
@@ -0,0 +1,372 @@
+package sorting
+
+import (
+	"fmt"
+
+	"github.com/RaviParvadiya/learn_dsa/instrument"
+	"github.com/RaviParvadiya/learn_dsa/records"
+)
+
+// CountingSortBasic is counting sort for non-negative integers. It returns
+// the sorted elements in a new slice.
+func CountingSortBasic(arr []int) []int {
+	return countingSortBasic(arr, nil)
+}
+
+func countingSortBasic(arr []int, p *instrument.Probe) []int {
+	mustNonNegative("CountingSortBasic", arr)
+
+	if len(arr) == 0 {
+		return arr
+	}
+
+	// Find max element
+	max := arr[0]
+	for i, v := range arr {
+		p.Compare(i, -1)
+		if v > max {
+			max = v
+		}
+	}
+
+	// Create count array
+	count := make([]int, max+1)
+	p.Alloc(len(count))
+
+	// Count occurrences
+	for _, v := range arr {
+		count[v]++
+	}
+
+	// Build output array
+	output := make([]int, 0, len(arr))
+	p.Alloc(cap(output))
+	for i := 0; i <= max; i++ {
+		for j := 0; j < count[i]; j++ {
+			output = append(output, i)
+		}
+	}
+
+	return output
+}
+
+// CountingSortInPlace is counting sort for non-negative integers that
+// overwrites arr.
+func CountingSortInPlace(arr []int) {
+	countingSortInPlace(arr, nil)
+}
+
+func countingSortInPlace(arr []int, p *instrument.Probe) {
+	mustNonNegative("CountingSortInPlace", arr)
+
+	if len(arr) == 0 {
+		return
+	}
+
+	// Find max element
+	max := arr[0]
+	for i, v := range arr {
+		p.Compare(i, -1)
+		if v > max {
+			max = v
+		}
+	}
+
+	// Create count array
+	count := make([]int, max+1)
+	p.Alloc(len(count))
+
+	// Count occurrences
+	for _, v := range arr {
+		count[v]++
+	}
+
+	// Overwrite original array
+	idx := 0
+	for i := 0; i <= max; i++ {
+		for j := 0; j < count[i]; j++ {
+			arr[idx] = i
+			p.Write(idx)
+			idx++
+		}
+	}
+}
+
+// CountingSortStable is stable counting sort for non-negative integers
+// (preserves relative order of equal elements). It returns the sorted
+// elements in a new slice.
+func CountingSortStable(arr []int) []int {
+	return countingSortStable(arr, nil)
+}
+
+func countingSortStable(arr []int, p *instrument.Probe) []int {
+	mustNonNegative("CountingSortStable", arr)
+
+	if len(arr) == 0 {
+		return arr
+	}
+
+	// Find max element
+	max := arr[0]
+	for i, v := range arr {
+		p.Compare(i, -1)
+		if v > max {
+			max = v
+		}
+	}
+
+	// Create count array
+	count := make([]int, max+1)
+	p.Alloc(len(count))
+
+	// Count occurrences
+	for _, v := range arr {
+		count[v]++
+	}
+
+	// Convert to cumulative count (prefix sum)
+	for i := 1; i <= max; i++ {
+		count[i] += count[i-1]
+	}
+
+	// Build output array (traverse from right to maintain stability)
+	output := make([]int, len(arr))
+	p.Alloc(len(output))
+	for i := len(arr) - 1; i >= 0; i-- {
+		val := arr[i]
+		output[count[val]-1] = val
+		count[val]--
+	}
+
+	return output
+}
+
+// CountingSortWithNegatives is counting sort that offsets the count array by
+// the minimum so negative numbers work. It returns the sorted elements in a
+// new slice.
+func CountingSortWithNegatives(arr []int) []int {
+	return countingSortWithNegatives(arr, nil)
+}
+
+func countingSortWithNegatives(arr []int, p *instrument.Probe) []int {
+	if len(arr) == 0 {
+		return arr
+	}
+
+	// Find min and max
+	min, max := arr[0], arr[0]
+	for i, v := range arr {
+		p.Compare(i, -1)
+		if v < min {
+			min = v
+		}
+		p.Compare(i, -1)
+		if v > max {
+			max = v
+		}
+	}
+
+	// Create count array with offset
+	rangeSize := max - min + 1
+	count := make([]int, rangeSize)
+	p.Alloc(len(count))
+
+	// Count occurrences (with offset)
+	for _, v := range arr {
+		count[v-min]++
+	}
+
+	// Build output array
+	output := make([]int, 0, len(arr))
+	p.Alloc(cap(output))
+	for i := 0; i < rangeSize; i++ {
+		for j := 0; j < count[i]; j++ {
+			output = append(output, i+min)
+		}
+	}
+
+	return output
+}
+
+// CountingSortLimitedRange is counting sort for values known to lie in
+// [min, max]. It panics if a value is out of range and returns the sorted
+// elements in a new slice.
+func CountingSortLimitedRange(arr []int, min, max int) []int {
+	return countingSortLimitedRange(arr, min, max, nil)
+}
+
+func countingSortLimitedRange(arr []int, min, max int, p *instrument.Probe) []int {
+	if len(arr) == 0 {
+		return arr
+	}
+
+	// Create count array
+	rangeSize := max - min + 1
+	count := make([]int, rangeSize)
+	p.Alloc(len(count))
+
+	// Count occurrences
+	for _, v := range arr {
+		if v < min || v > max {
+			panic("Value out of specified range")
+		}
+		count[v-min]++
+	}
+
+	// Build output array
+	output := make([]int, 0, len(arr))
+	p.Alloc(cap(output))
+	for i := 0; i < rangeSize; i++ {
+		for j := 0; j < count[i]; j++ {
+			output = append(output, i+min)
+		}
+	}
+
+	return output
+}
+
+// CountingSortObjects stably sorts people by Age. It returns the sorted
+// elements in a new slice.
+func CountingSortObjects(arr []records.Person) []records.Person {
+	return CountingSortByKey(arr, func(p records.Person) int { return p.Age })
+}
+
+// countingKeyRange and countingKeySlack bound the range of keys
+// CountingSortByKey counts over: up to countingKeyRange, or countingKeySlack
+// times the number of elements if that is more.
+const (
+	countingKeyRange = 1 << 20
+	countingKeySlack = 16
+)
+
+// CountingSortByKey stably sorts arr by the integer key(v), counting keys
+// over the range from the smallest to the largest. It suits keys with a
+// range not much wider than len(arr), and panics if hi-lo, the difference
+// of the largest and smallest keys, exceeds both 1<<20 and 16*len(arr). It
+// returns the sorted elements in a new slice.
+func CountingSortByKey[T any](arr []T, key func(T) int) []T {
+	if len(arr) == 0 {
+		return arr
+	}
+
+	keys := make([]int, len(arr))
+	lo, hi := key(arr[0]), key(arr[0])
+	for i, v := range arr {
+		keys[i] = key(v)
+		lo = min(lo, keys[i])
+		hi = max(hi, keys[i])
+	}
+
+	// uint(hi-lo) is the span even where hi-lo overflows.
+	if span := uint(hi - lo); span > countingKeyRange && span > uint(countingKeySlack*len(arr)) {
+		panic(fmt.Sprintf("sorting: key range too wide for counting sort: [%d, %d] for %d elements", lo, hi, len(arr)))
+	}
+
+	// count[k-lo] is where the next element with key k goes
+	count := make([]int, hi-lo+2)
+	for _, k := range keys {
+		count[k-lo+1]++
+	}
+	for i := 1; i < len(count); i++ {
+		count[i] += count[i-1]
+	}
+
+	output := make([]T, len(arr))
+	for i, v := range arr {
+		output[count[keys[i]-lo]] = v
+		count[keys[i]-lo]++
+	}
+	return output
+}
+
+// CountingSortOptimized is counting sort with a count array sized to the
+// actual value range. It returns the sorted elements in a new slice.
+func CountingSortOptimized(arr []int) []int {
+	return countingSortOptimized(arr, nil)
+}
+
+func countingSortOptimized(arr []int, p *instrument.Probe) []int {
+	if len(arr) == 0 {
+		return arr
+	}
+
+	// Find min and max in single pass
+	min, max := arr[0], arr[0]
+	for i, v := range arr {
+		p.Compare(i, -1)
+		if v < min {
+			min = v
+		}
+		p.Compare(i, -1)
+		if v > max {
+			max = v
+		}
+	}
+
+	// If all elements are same
+	if min == max {
+		return arr
+	}
+
+	// Create count array with optimal size
+	rangeSize := max - min + 1
+	count := make([]int, rangeSize)
+	p.Alloc(len(count))
+
+	// Count occurrences
+	for _, v := range arr {
+		count[v-min]++
+	}
+
+	// Build output array
+	output := make([]int, len(arr))
+	p.Alloc(len(output))
+	idx := 0
+	for i := 0; i < rangeSize; i++ {
+		for j := 0; j < count[i]; j++ {
+			output[idx] = i + min
+			idx++
+		}
+	}
+
+	return output
+}
+
+// CountingSortRadix stably sorts arr, of non-negative integers, by the
+// decimal digit at place exp; it is the subroutine LSD radix sort runs once
+// per digit.
+func CountingSortRadix(arr []int, exp int) {
+	mustNonNegative("CountingSortRadix", arr)
+	countingSortRadix(arr, exp, nil)
+}
+
+// countingSortRadix is CountingSortRadix without the check for negatives,
+// for radix sorts that check once before their first pass.
+func countingSortRadix(arr []int, exp int, p *instrument.Probe) {
+	n := len(arr)
+	output := make([]int, n)
+	count := make([]int, 10) // For decimal digits 0-9
+	p.Alloc(n)
+	p.Alloc(10)
+
+	// Count occurrences of digits
+	for i := 0; i < n; i++ {
+		digit := (arr[i] / exp) % 10
+		count[digit]++
+	}
+
+	// Convert to cumulative count
+	for i := 1; i < 10; i++ {
+		count[i] += count[i-1]
+	}
+
+	// Build output array (stable)
+	for i := n - 1; i >= 0; i-- {
+		digit := (arr[i] / exp) % 10
+		output[count[digit]-1] = arr[i]
+		count[digit]--
+	}
+
+	// Copy output to arr
+	copyInto(arr, 0, output, p)
+}
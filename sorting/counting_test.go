@@ -0,0 +1,82 @@
+package sorting_test
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/RaviParvadiya/learn_dsa/sorting"
+)
+
+// spread returns n keys from lo to lo+span, both ends included.
+func spread(n, lo int, span uint) []int {
+	keys := make([]int, n)
+	for i := range keys {
+		keys[i] = lo + int(span/uint(n-1)*uint(i))
+	}
+	keys[n-1] = lo + int(span)
+	return keys
+}
+
+// TestCountingSortByKeyRange checks that CountingSortByKey sorts keys
+// spanning up to 1<<20, or 16 times their number, and panics with an error
+// of its own beyond both, instead of overflowing or allocating gigabytes.
+func TestCountingSortByKeyRange(t *testing.T) {
+	const keyRange, slack = 1 << 20, 16
+	const big = 1 << 17 // big*slack exceeds keyRange
+	tests := []struct {
+		name string
+		keys []int
+		ok   bool
+	}{
+		{"fixed range", spread(2, 0, keyRange), true},
+		{"fixed range negative", spread(3, -keyRange/2, keyRange), true},
+		{"past fixed range", spread(2, 0, keyRange+1), false},
+		{"near max", spread(2, math.MaxInt-keyRange, keyRange), true},
+		{"near min", spread(2, math.MinInt, keyRange), true},
+		{"slack", spread(big, 7, big*slack), true},
+		{"past slack", spread(big, 7, big*slack+1), false},
+		{"whole int range", []int{math.MinInt, math.MaxInt}, false},
+		{"overflowing", []int{math.MinInt, 0, math.MaxInt, -1}, false},
+		{"wide", []int{0, 1 << 40}, false},
+	}
+	for _, tt := range tests {
+		func() {
+			defer func() {
+				r := recover()
+				if tt.ok && r != nil {
+					t.Errorf("%s: panicked: %v", tt.name, r)
+				}
+				if msg, _ := r.(string); !tt.ok && !strings.HasPrefix(msg, "sorting: key range too wide for counting sort") {
+					t.Errorf("%s: got panic %v, want a key range error", tt.name, r)
+				}
+			}()
+			input := slices.Clone(tt.keys)
+			slices.Reverse(input)
+			got := sorting.CountingSortByKey(input, func(k int) int { return k })
+			if !slices.Equal(got, tt.keys) {
+				t.Errorf("%s: not sorted", tt.name)
+			}
+			perm := sorting.ArgsortCounting(input)
+			checkArgsort(t, tt.name+"/argsort", input, perm, true)
+		}()
+	}
+}
+
+// TestCountingSortRadix checks one digit pass, which must be stable for LSD
+// radix sort to work, and the check for negatives.
+func TestCountingSortRadix(t *testing.T) {
+	arr := []int{170, 45, 75, 90, 802, 24, 2, 66}
+	sorting.CountingSortRadix(arr, 10)
+	if want := []int{802, 2, 24, 45, 66, 170, 75, 90}; !slices.Equal(arr, want) {
+		t.Errorf("by tens: got %v, want %v", arr, want)
+	}
+
+	defer func() {
+		if msg, _ := recover().(string); !strings.HasPrefix(msg, "sorting: ") {
+			t.Errorf("got panic %q, want a sorting error", msg)
+		}
+	}()
+	sorting.CountingSortRadix([]int{3, -1, 2}, 1)
+}
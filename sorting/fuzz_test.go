@@ -0,0 +1,66 @@
+package sorting_test
+
+import (
+	"encoding/binary"
+	"slices"
+	"testing"
+
+	"github.com/RaviParvadiya/learn_dsa/sorting"
+)
+
+// ints decodes data as little-endian int16s, which keeps the values within
+// reach of the counting sorts while still covering negatives and duplicates.
+func ints(data []byte) []int {
+	arr := make([]int, len(data)/2)
+	for i := range arr {
+		arr[i] = int(int16(binary.LittleEndian.Uint16(data[2*i:])))
+	}
+	return arr
+}
+
+// FuzzSort checks every registered variant against slices.Sort on inputs it
+// declares support for, and checks the stable comparison sorts for
+// stability.
+func FuzzSort(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{1, 0})
+	f.Add([]byte{3, 0, 1, 0, 2, 0, 1, 0})
+	f.Add([]byte{0xff, 0xff, 0, 0, 0xfe, 0xff, 5, 0})
+	f.Add([]byte{0, 0x80, 0xff, 0x7f, 0, 0, 0, 0x80})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		input := ints(data)
+		negatives := slices.ContainsFunc(input, func(v int) bool { return v < 0 })
+		for _, a := range sorting.Algorithms() {
+			if negatives && !a.Negatives {
+				continue
+			}
+			if a.MaxRange > 0 && len(input) > 0 && slices.Max(input)-slices.Min(input) > a.MaxRange {
+				continue
+			}
+			got := slices.Clone(input)
+			a.Sort(got, nil)
+			checkSorted(t, a.Name, input, got)
+			if a.Stable {
+				checkStableOn(t, a, input)
+			}
+		}
+	})
+}
+
+// checkStableOn sorts input by its low byte, keeping each value's position
+// in the high bits, and fails t if equal low bytes are reordered.
+func checkStableOn(t *testing.T, a sorting.Algorithm, input []int) {
+	t.Helper()
+	arr := make([]int, len(input))
+	for i, v := range input {
+		arr[i] = i<<8 | v&0xff
+	}
+	a.SortFunc(arr, func(x, y int) bool { return x&0xff < y&0xff }, nil)
+	for i := 1; i < len(arr); i++ {
+		if arr[i-1]&0xff == arr[i]&0xff && arr[i-1] > arr[i] {
+			t.Errorf("%s: unstable: positions %d and %d of key %d swapped",
+				a.Name, arr[i-1]>>8, arr[i]>>8, arr[i]&0xff)
+			return
+		}
+	}
+}
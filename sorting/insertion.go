@@ -0,0 +1,333 @@
+package sorting
+
+import (
+	"cmp"
+
+	"github.com/RaviParvadiya/learn_dsa/instrument"
+	"github.com/RaviParvadiya/learn_dsa/records"
+)
+
+// InsertionSortBasic is insertion sort with shifting.
+func InsertionSortBasic[T cmp.Ordered](arr []T) {
+	insertionSortBasic(arr, cmp.Less[T], nil)
+}
+
+// InsertionSortBasicFunc is InsertionSortBasic ordered by less.
+func InsertionSortBasicFunc[T any](arr []T, less func(a, b T) bool) {
+	insertionSortBasic(arr, less, nil)
+}
+
+func insertionSortBasic[T any](arr []T, less func(a, b T) bool, p *instrument.Probe) {
+	n := len(arr)
+	for i := 1; i < n; i++ {
+		key := arr[i]
+		j := i - 1
+
+		// Shift elements greater than key to the right
+		for j >= 0 {
+			p.Compare(-1, j)
+			if !less(key, arr[j]) {
+				break
+			}
+			arr[j+1] = arr[j]
+			p.Write(j + 1)
+			j--
+		}
+		arr[j+1] = key
+		p.Write(j + 1)
+	}
+}
+
+// InsertionSortSwapping is insertion sort with swapping (instead of shifting).
+func InsertionSortSwapping[T cmp.Ordered](arr []T) {
+	insertionSortSwapping(arr, cmp.Less[T], nil)
+}
+
+// InsertionSortSwappingFunc is InsertionSortSwapping ordered by less.
+func InsertionSortSwappingFunc[T any](arr []T, less func(a, b T) bool) {
+	insertionSortSwapping(arr, less, nil)
+}
+
+func insertionSortSwapping[T any](arr []T, less func(a, b T) bool, p *instrument.Probe) {
+	n := len(arr)
+	for i := 1; i < n; i++ {
+		j := i
+		// Swap adjacent elements until correct position
+		for j > 0 {
+			p.Compare(j, j-1)
+			if !less(arr[j], arr[j-1]) {
+				break
+			}
+			arr[j], arr[j-1] = arr[j-1], arr[j]
+			p.Swap(j, j-1)
+			j--
+		}
+	}
+}
+
+// InsertionSortBinary is binary insertion sort (uses binary search to find
+// the position).
+func InsertionSortBinary[T cmp.Ordered](arr []T) {
+	insertionSortBinary(arr, cmp.Less[T], nil)
+}
+
+// InsertionSortBinaryFunc is InsertionSortBinary ordered by less.
+func InsertionSortBinaryFunc[T any](arr []T, less func(a, b T) bool) {
+	insertionSortBinary(arr, less, nil)
+}
+
+func insertionSortBinary[T any](arr []T, less func(a, b T) bool, p *instrument.Probe) {
+	binaryInsertion(arr, 0, 1, len(arr), less, p)
+}
+
+// binaryInsertion sorts arr[lo:hi] by binary insertion, given that
+// arr[lo:start] is already sorted. Timsort uses it to extend short runs.
+func binaryInsertion[T any](arr []T, lo, start, hi int, less func(a, b T) bool, p *instrument.Probe) {
+	for i := max(start, lo+1); i < hi; i++ {
+		key := arr[i]
+
+		// Binary search to find insertion position
+		pos := binarySearch(arr, key, lo, i-1, less, p)
+
+		// Shift elements to make space
+		for j := i - 1; j >= pos; j-- {
+			arr[j+1] = arr[j]
+			p.Write(j + 1)
+		}
+		arr[pos] = key
+		p.Write(pos)
+	}
+}
+
+// binarySearch returns the index after the last element of arr[low..high]
+// that is not greater than key, which keeps binary insertion sort stable.
+func binarySearch[T any](arr []T, key T, low, high int, less func(a, b T) bool, p *instrument.Probe) int {
+	for low <= high {
+		mid := low + (high-low)/2
+		p.Compare(-1, mid)
+		if less(key, arr[mid]) {
+			high = mid - 1
+		} else {
+			low = mid + 1
+		}
+	}
+	return low
+}
+
+// InsertionSortRecursive sorts the first n elements of arr recursively.
+func InsertionSortRecursive[T cmp.Ordered](arr []T, n int) {
+	insertionSortRecursive(arr, n, cmp.Less[T], nil)
+}
+
+// InsertionSortRecursiveFunc is InsertionSortRecursive ordered by less.
+func InsertionSortRecursiveFunc[T any](arr []T, n int, less func(a, b T) bool) {
+	insertionSortRecursive(arr, n, less, nil)
+}
+
+func insertionSortRecursive[T any](arr []T, n int, less func(a, b T) bool, p *instrument.Probe) {
+	p.Enter()
+	defer p.Leave()
+
+	// Base case
+	if n <= 1 {
+		return
+	}
+
+	// Sort first n-1 elements
+	insertionSortRecursive(arr, n-1, less, p)
+
+	// Insert last element at correct position
+	key := arr[n-1]
+	j := n - 2
+
+	for j >= 0 {
+		p.Compare(-1, j)
+		if !less(key, arr[j]) {
+			break
+		}
+		arr[j+1] = arr[j]
+		p.Write(j + 1)
+		j--
+	}
+	arr[j+1] = key
+	p.Write(j + 1)
+}
+
+// InsertionSortSentinel is sentinel insertion sort (avoids the boundary check).
+func InsertionSortSentinel[T cmp.Ordered](arr []T) {
+	insertionSortSentinel(arr, cmp.Less[T], nil)
+}
+
+// InsertionSortSentinelFunc is InsertionSortSentinel ordered by less.
+func InsertionSortSentinelFunc[T any](arr []T, less func(a, b T) bool) {
+	insertionSortSentinel(arr, less, nil)
+}
+
+func insertionSortSentinel[T any](arr []T, less func(a, b T) bool, p *instrument.Probe) {
+	n := len(arr)
+	if n <= 1 {
+		return
+	}
+
+	// Find minimum and place it at the beginning (sentinel)
+	minIdx := 0
+	for i := 1; i < n; i++ {
+		p.Compare(i, minIdx)
+		if less(arr[i], arr[minIdx]) {
+			minIdx = i
+		}
+	}
+	arr[0], arr[minIdx] = arr[minIdx], arr[0]
+	p.Swap(0, minIdx)
+
+	// Now we can skip the j >= 0 check
+	for i := 2; i < n; i++ {
+		key := arr[i]
+		j := i - 1
+
+		// No need to check j >= 0 because sentinel guarantees we'll stop
+		for {
+			p.Compare(-1, j)
+			if !less(key, arr[j]) {
+				break
+			}
+			arr[j+1] = arr[j]
+			p.Write(j + 1)
+			j--
+		}
+		arr[j+1] = key
+		p.Write(j + 1)
+	}
+}
+
+// InsertionSortWithGap is one gapped insertion pass (the Shell sort building
+// block). A final pass with gap 1 leaves arr sorted.
+func InsertionSortWithGap[T cmp.Ordered](arr []T, gap int) {
+	insertionSortWithGap(arr, gap, cmp.Less[T], nil)
+}
+
+// InsertionSortWithGapFunc is InsertionSortWithGap ordered by less.
+func InsertionSortWithGapFunc[T any](arr []T, gap int, less func(a, b T) bool) {
+	insertionSortWithGap(arr, gap, less, nil)
+}
+
+func insertionSortWithGap[T any](arr []T, gap int, less func(a, b T) bool, p *instrument.Probe) {
+	n := len(arr)
+	for i := gap; i < n; i++ {
+		key := arr[i]
+		j := i - gap
+
+		for j >= 0 {
+			p.Compare(-1, j)
+			if !less(key, arr[j]) {
+				break
+			}
+			arr[j+gap] = arr[j]
+			p.Write(j + gap)
+			j -= gap
+		}
+		arr[j+gap] = key
+		p.Write(j + gap)
+	}
+}
+
+// InsertionSortBidirectional is bidirectional (cocktail) insertion sort.
+func InsertionSortBidirectional[T cmp.Ordered](arr []T) {
+	insertionSortBidirectional(arr, cmp.Less[T], nil)
+}
+
+// InsertionSortBidirectionalFunc is InsertionSortBidirectional ordered by
+// less.
+func InsertionSortBidirectionalFunc[T any](arr []T, less func(a, b T) bool) {
+	insertionSortBidirectional(arr, less, nil)
+}
+
+func insertionSortBidirectional[T any](arr []T, less func(a, b T) bool, p *instrument.Probe) {
+	n := len(arr)
+	for i := 1; i < n; i++ {
+		key := arr[i]
+
+		// Check if we need to move left or right
+		p.Compare(-1, i-1)
+		if less(key, arr[i-1]) {
+			// Move left (standard insertion)
+			j := i - 1
+			for j >= 0 {
+				p.Compare(-1, j)
+				if !less(key, arr[j]) {
+					break
+				}
+				arr[j+1] = arr[j]
+				p.Write(j + 1)
+				j--
+			}
+			arr[j+1] = key
+			p.Write(j + 1)
+		}
+	}
+}
+
+// InsertionSortEarlyTermination is insertion sort that skips elements already
+// in position.
+func InsertionSortEarlyTermination[T cmp.Ordered](arr []T) {
+	insertionSortEarlyTermination(arr, cmp.Less[T], nil)
+}
+
+// InsertionSortEarlyTerminationFunc is InsertionSortEarlyTermination ordered
+// by less.
+func InsertionSortEarlyTerminationFunc[T any](arr []T, less func(a, b T) bool) {
+	insertionSortEarlyTermination(arr, less, nil)
+}
+
+func insertionSortEarlyTermination[T any](arr []T, less func(a, b T) bool, p *instrument.Probe) {
+	n := len(arr)
+	for i := 1; i < n; i++ {
+		// If element is already in correct position, skip
+		p.Compare(i, i-1)
+		if !less(arr[i], arr[i-1]) {
+			continue
+		}
+
+		key := arr[i]
+		j := i - 1
+
+		for j >= 0 {
+			p.Compare(-1, j)
+			if !less(key, arr[j]) {
+				break
+			}
+			arr[j+1] = arr[j]
+			p.Write(j + 1)
+			j--
+		}
+		arr[j+1] = key
+		p.Write(j + 1)
+	}
+}
+
+// InsertionSortObjects stably sorts students by Score.
+func InsertionSortObjects(arr []records.Student) {
+	insertionSortBasic(arr, func(a, b records.Student) bool {
+		return a.Score < b.Score
+	}, nil)
+}
+
+// insertionSort sorts arr[low..high]; the hybrid quick and merge sorts use it
+// to finish small ranges.
+func insertionSort[T any](arr []T, low, high int, less func(a, b T) bool, p *instrument.Probe) {
+	for i := low + 1; i <= high; i++ {
+		key := arr[i]
+		j := i - 1
+		for j >= low {
+			p.Compare(-1, j)
+			if !less(key, arr[j]) {
+				break
+			}
+			arr[j+1] = arr[j]
+			p.Write(j + 1)
+			j--
+		}
+		arr[j+1] = key
+		p.Write(j + 1)
+	}
+}
@@ -0,0 +1,112 @@
+package sorting
+
+import (
+	"math/bits"
+
+	"github.com/RaviParvadiya/learn_dsa/instrument"
+)
+
+// introThreshold is the size below which introsort leaves a range to
+// insertion sort.
+const introThreshold = 16
+
+// introsort sorts arr with quicksort, partitioning around the median of the
+// first, middle and last elements. Once depth partitions have been made on
+// one path it switches to heapsort, so the worst case is O(n log n) time
+// and O(log n) stack, and it finishes small ranges with insertion sort.
+func introsort[T any](arr []T, less func(a, b T) bool, p *instrument.Probe) {
+	introsortRange(arr, 0, len(arr)-1, 2*bits.Len(uint(len(arr))), less, p)
+}
+
+func introsortRange[T any](arr []T, low, high, depth int, less func(a, b T) bool, p *instrument.Probe) {
+	p.Enter()
+	defer p.Leave()
+
+	if high-low < introThreshold {
+		insertionSort(arr, low, high, less, p)
+		return
+	}
+	if depth == 0 {
+		heapSort(arr, low, high, less, p)
+		return
+	}
+
+	p.Split(low, high)
+	if medianToLow(arr, low, high, less, p) {
+		// Equal samples hint at many duplicates: set the keys equal to the
+		// pivot aside so they are not partitioned again.
+		lt, gt := partitionThreeWay(arr, low, high, less, p)
+		introsortRange(arr, low, lt-1, depth-1, less, p)
+		introsortRange(arr, gt+1, high, depth-1, less, p)
+		return
+	}
+	pi := partitionHoare(arr, low, high, less, p)
+	introsortRange(arr, low, pi, depth-1, less, p)
+	introsortRange(arr, pi+1, high, depth-1, less, p)
+}
+
+// medianToLow orders arr[low], arr[mid] and arr[high], moves their median to
+// arr[low] to serve as the pivot, and reports whether two of them are equal.
+func medianToLow[T any](arr []T, low, high int, less func(a, b T) bool, p *instrument.Probe) bool {
+	mid := low + (high-low)/2
+	sort3(arr, low, mid, high, less, p)
+
+	p.Compare(low, mid)
+	p.Compare(mid, high)
+	dup := !less(arr[low], arr[mid]) || !less(arr[mid], arr[high])
+
+	arr[low], arr[mid] = arr[mid], arr[low]
+	p.Swap(low, mid)
+	return dup
+}
+
+// sort3 orders arr[a] <= arr[b] <= arr[c].
+func sort3[T any](arr []T, a, b, c int, less func(a, b T) bool, p *instrument.Probe) {
+	order := func(i, j int) {
+		p.Compare(j, i)
+		if less(arr[j], arr[i]) {
+			arr[i], arr[j] = arr[j], arr[i]
+			p.Swap(i, j)
+		}
+	}
+	order(a, b)
+	order(b, c)
+	order(a, b)
+}
+
+// heapSort sorts arr[low..high] with a max-heap rooted at low.
+func heapSort[T any](arr []T, low, high int, less func(a, b T) bool, p *instrument.Probe) {
+	n := high - low + 1
+	for i := n/2 - 1; i >= 0; i-- {
+		siftDown(arr, low, i, n, less, p)
+	}
+	for end := n - 1; end > 0; end-- {
+		arr[low], arr[low+end] = arr[low+end], arr[low]
+		p.Swap(low, low+end)
+		siftDown(arr, low, 0, end, less, p)
+	}
+}
+
+// siftDown restores the heap property below node root of the heap of n
+// elements starting at arr[off].
+func siftDown[T any](arr []T, off, root, n int, less func(a, b T) bool, p *instrument.Probe) {
+	for {
+		child := 2*root + 1
+		if child >= n {
+			return
+		}
+		if child+1 < n {
+			p.Compare(off+child, off+child+1)
+			if less(arr[off+child], arr[off+child+1]) {
+				child++
+			}
+		}
+		p.Compare(off+root, off+child)
+		if !less(arr[off+root], arr[off+child]) {
+			return
+		}
+		arr[off+root], arr[off+child] = arr[off+child], arr[off+root]
+		p.Swap(off+root, off+child)
+		root = child
+	}
+}
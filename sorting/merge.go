@@ -0,0 +1,483 @@
+package sorting
+
+import (
+	"cmp"
+
+	"github.com/RaviParvadiya/learn_dsa/comparator"
+	"github.com/RaviParvadiya/learn_dsa/instrument"
+	"github.com/RaviParvadiya/learn_dsa/records"
+)
+
+// MergeSort1 sorts arr[low..high], building each merge in a temp slice with
+// append.
+func MergeSort1[T cmp.Ordered](arr []T, low, high int) {
+	mergeSort1(arr, low, high, cmp.Less[T], nil)
+}
+
+// MergeSort1Func is MergeSort1 ordered by less.
+func MergeSort1Func[T any](arr []T, low, high int, less func(a, b T) bool) {
+	mergeSort1(arr, low, high, less, nil)
+}
+
+func mergeSort1[T any](arr []T, low, high int, less func(a, b T) bool, p *instrument.Probe) {
+	p.Enter()
+	defer p.Leave()
+
+	if low >= high {
+		return
+	}
+
+	mid := low + (high-low)/2
+	p.Split(low, high)
+	mergeSort1(arr, low, mid, less, p)
+	mergeSort1(arr, mid+1, high, less, p)
+
+	merge1(arr, low, mid, high, less, p)
+}
+
+func merge1[T any](arr []T, low, mid, high int, less func(a, b T) bool, p *instrument.Probe) {
+	p.Merge(low, mid, high)
+
+	temp := make([]T, 0, high-low+1)
+	p.Alloc(cap(temp))
+
+	i := low
+	j := mid + 1
+
+	for i <= mid && j <= high {
+		p.Compare(j, i)
+		if !less(arr[j], arr[i]) {
+			temp = append(temp, arr[i])
+			i++
+		} else {
+			temp = append(temp, arr[j])
+			j++
+		}
+	}
+
+	// copy remaining elements
+	for i <= mid {
+		temp = append(temp, arr[i])
+		i++
+	}
+	for j <= high {
+		temp = append(temp, arr[j])
+		j++
+	}
+
+	// copy back to original array
+	for k := 0; k < len(temp); k++ {
+		arr[low+k] = temp[k]
+		p.Write(low + k)
+	}
+}
+
+// MergeSort2 sorts arr[low..high], building each merge in a preallocated
+// temp slice with an explicit write index.
+func MergeSort2[T cmp.Ordered](arr []T, low, high int) {
+	mergeSort2(arr, low, high, cmp.Less[T], nil)
+}
+
+// MergeSort2Func is MergeSort2 ordered by less.
+func MergeSort2Func[T any](arr []T, low, high int, less func(a, b T) bool) {
+	mergeSort2(arr, low, high, less, nil)
+}
+
+func mergeSort2[T any](arr []T, low, high int, less func(a, b T) bool, p *instrument.Probe) {
+	p.Enter()
+	defer p.Leave()
+
+	if low >= high {
+		return
+	}
+
+	mid := low + (high-low)/2
+	p.Split(low, high)
+	mergeSort2(arr, low, mid, less, p)
+	mergeSort2(arr, mid+1, high, less, p)
+
+	merge2(arr, low, mid, high, less, p)
+}
+
+func merge2[T any](arr []T, low, mid, high int, less func(a, b T) bool, p *instrument.Probe) {
+	p.Merge(low, mid, high)
+
+	temp := make([]T, high-low+1)
+	p.Alloc(len(temp))
+
+	i := low     // left pointer
+	j := mid + 1 // right pointer
+	k := 0       // temp pointer
+
+	for i <= mid && j <= high {
+		p.Compare(j, i)
+		if !less(arr[j], arr[i]) {
+			temp[k] = arr[i]
+			i++
+		} else {
+			temp[k] = arr[j]
+			j++
+		}
+		k++
+	}
+
+	// remaining left part
+	for i <= mid {
+		temp[k] = arr[i]
+		i++
+		k++
+	}
+
+	// remaining right part
+	for j <= high {
+		temp[k] = arr[j]
+		j++
+		k++
+	}
+
+	// copy back
+	for x := 0; x < len(temp); x++ {
+		arr[low+x] = temp[x]
+		p.Write(low + x)
+	}
+}
+
+// MergeSortBasic is the basic recursive (top-down) merge sort. It returns
+// the sorted elements in a new slice.
+func MergeSortBasic[T cmp.Ordered](arr []T) []T {
+	return mergeSortBasic(arr, cmp.Less[T], nil)
+}
+
+// MergeSortBasicFunc is MergeSortBasic ordered by less.
+func MergeSortBasicFunc[T any](arr []T, less func(a, b T) bool) []T {
+	return mergeSortBasic(arr, less, nil)
+}
+
+func mergeSortBasic[T any](arr []T, less func(a, b T) bool, p *instrument.Probe) []T {
+	p.Enter()
+	defer p.Leave()
+
+	if len(arr) <= 1 {
+		return arr
+	}
+
+	mid := len(arr) / 2
+	left := mergeSortBasic(arr[:mid], less, p)
+	right := mergeSortBasic(arr[mid:], less, p)
+
+	return merge(left, right, less, p)
+}
+
+// merge returns left and right merged into a new slice. The halves are not
+// positions of the input, so comparisons are recorded against index -1.
+func merge[T any](left, right []T, less func(a, b T) bool, p *instrument.Probe) []T {
+	result := make([]T, 0, len(left)+len(right))
+	p.Alloc(cap(result))
+	i, j := 0, 0
+
+	for i < len(left) && j < len(right) {
+		p.Compare(-1, -1)
+		if !less(right[j], left[i]) {
+			result = append(result, left[i])
+			i++
+		} else {
+			result = append(result, right[j])
+			j++
+		}
+	}
+
+	result = append(result, left[i:]...)
+	result = append(result, right[j:]...)
+
+	return result
+}
+
+// MergeSortInPlace is top-down merge sort of arr[left..right] that merges
+// with SymMerge, so it is stable and allocates nothing.
+func MergeSortInPlace[T cmp.Ordered](arr []T, left, right int) {
+	mergeSortInPlace(arr, left, right, cmp.Less[T], nil)
+}
+
+// MergeSortInPlaceFunc is MergeSortInPlace ordered by less.
+func MergeSortInPlaceFunc[T any](arr []T, left, right int, less func(a, b T) bool) {
+	mergeSortInPlace(arr, left, right, less, nil)
+}
+
+func mergeSortInPlace[T any](arr []T, left, right int, less func(a, b T) bool, p *instrument.Probe) {
+	p.Enter()
+	defer p.Leave()
+
+	if left < right {
+		mid := left + (right-left)/2
+		p.Split(left, right)
+
+		mergeSortInPlace(arr, left, mid, less, p)
+		mergeSortInPlace(arr, mid+1, right, less, p)
+
+		symMerge(arr, left, mid+1, right+1, less, p)
+	}
+}
+
+// mergeCopies merges arr[left..mid] and arr[mid+1..right] through copies of
+// both halves.
+func mergeCopies[T any](arr []T, left, mid, right int, less func(a, b T) bool, p *instrument.Probe) {
+	p.Merge(left, mid, right)
+
+	// Create temp arrays
+	n1 := mid - left + 1
+	n2 := right - mid
+
+	leftArr := make([]T, n1)
+	rightArr := make([]T, n2)
+	p.Alloc(n1)
+	p.Alloc(n2)
+
+	copy(leftArr, arr[left:mid+1])
+	copy(rightArr, arr[mid+1:right+1])
+
+	// Merge back into original array
+	i, j, k := 0, 0, left
+
+	for i < n1 && j < n2 {
+		// Compare at the elements' original positions
+		p.Compare(mid+1+j, left+i)
+		if !less(rightArr[j], leftArr[i]) {
+			arr[k] = leftArr[i]
+			i++
+		} else {
+			arr[k] = rightArr[j]
+			j++
+		}
+		p.Write(k)
+		k++
+	}
+
+	for i < n1 {
+		arr[k] = leftArr[i]
+		p.Write(k)
+		i++
+		k++
+	}
+
+	for j < n2 {
+		arr[k] = rightArr[j]
+		p.Write(k)
+		j++
+		k++
+	}
+}
+
+// MergeSortIterative is iterative (bottom-up) merge sort.
+func MergeSortIterative[T cmp.Ordered](arr []T) {
+	mergeSortIterative(arr, cmp.Less[T], nil)
+}
+
+// MergeSortIterativeFunc is MergeSortIterative ordered by less.
+func MergeSortIterativeFunc[T any](arr []T, less func(a, b T) bool) {
+	mergeSortIterative(arr, less, nil)
+}
+
+func mergeSortIterative[T any](arr []T, less func(a, b T) bool, p *instrument.Probe) {
+	n := len(arr)
+
+	// Start with merge subarrays of size 1, then 2, 4, 8...
+	for size := 1; size < n; size *= 2 {
+		// Pick starting index of left sub array
+		for left := 0; left < n-1; left += 2 * size {
+			mid := min(left+size-1, n-1)
+			right := min(left+2*size-1, n-1)
+
+			mergeCopies(arr, left, mid, right, less, p)
+		}
+	}
+}
+
+// MergeSortThreeWay is merge sort that divides into three parts. It returns
+// the sorted elements in a new slice.
+func MergeSortThreeWay[T cmp.Ordered](arr []T) []T {
+	return mergeSortThreeWay(arr, cmp.Less[T], nil)
+}
+
+// MergeSortThreeWayFunc is MergeSortThreeWay ordered by less.
+func MergeSortThreeWayFunc[T any](arr []T, less func(a, b T) bool) []T {
+	return mergeSortThreeWay(arr, less, nil)
+}
+
+func mergeSortThreeWay[T any](arr []T, less func(a, b T) bool, p *instrument.Probe) []T {
+	p.Enter()
+	defer p.Leave()
+
+	if len(arr) <= 1 {
+		return arr
+	}
+
+	// Divide into three parts (rounding up so no part is empty)
+	third := (len(arr) + 2) / 3
+
+	left := mergeSortThreeWay(arr[:third], less, p)
+	mid := mergeSortThreeWay(arr[third:2*third], less, p)
+	right := mergeSortThreeWay(arr[2*third:], less, p)
+
+	return mergeThree(left, mid, right, less, p)
+}
+
+func mergeThree[T any](left, mid, right []T, less func(a, b T) bool, p *instrument.Probe) []T {
+	result := make([]T, 0, len(left)+len(mid)+len(right))
+	p.Alloc(cap(result))
+	i, j, k := 0, 0, 0
+
+	// lessAt is less, recorded on p; the parts are not positions of the input
+	lessAt := func(a, b T) bool {
+		p.Compare(-1, -1)
+		return less(a, b)
+	}
+
+	// Merge all three
+	for i < len(left) && j < len(mid) && k < len(right) {
+		if !lessAt(mid[j], left[i]) && !lessAt(right[k], left[i]) {
+			result = append(result, left[i])
+			i++
+		} else if !lessAt(left[i], mid[j]) && !lessAt(right[k], mid[j]) {
+			result = append(result, mid[j])
+			j++
+		} else {
+			result = append(result, right[k])
+			k++
+		}
+	}
+
+	// Merge remaining two
+	for i < len(left) && j < len(mid) {
+		if !lessAt(mid[j], left[i]) {
+			result = append(result, left[i])
+			i++
+		} else {
+			result = append(result, mid[j])
+			j++
+		}
+	}
+
+	for i < len(left) && k < len(right) {
+		if !lessAt(right[k], left[i]) {
+			result = append(result, left[i])
+			i++
+		} else {
+			result = append(result, right[k])
+			k++
+		}
+	}
+
+	for j < len(mid) && k < len(right) {
+		if !lessAt(right[k], mid[j]) {
+			result = append(result, mid[j])
+			j++
+		} else {
+			result = append(result, right[k])
+			k++
+		}
+	}
+
+	// Append remaining elements
+	result = append(result, left[i:]...)
+	result = append(result, mid[j:]...)
+	result = append(result, right[k:]...)
+
+	return result
+}
+
+// MergeSortHybrid is merge sort that finishes small ranges with insertion
+// sort.
+func MergeSortHybrid[T cmp.Ordered](arr []T, left, right int) {
+	mergeSortHybrid(arr, left, right, cmp.Less[T], nil)
+}
+
+// MergeSortHybridFunc is MergeSortHybrid ordered by less.
+func MergeSortHybridFunc[T any](arr []T, left, right int, less func(a, b T) bool) {
+	mergeSortHybrid(arr, left, right, less, nil)
+}
+
+func mergeSortHybrid[T any](arr []T, left, right int, less func(a, b T) bool, p *instrument.Probe) {
+	p.Enter()
+	defer p.Leave()
+
+	const threshold = 10
+
+	if right-left <= threshold {
+		insertionSort(arr, left, right, less, p)
+		return
+	}
+
+	if left < right {
+		mid := left + (right-left)/2
+		p.Split(left, right)
+		mergeSortHybrid(arr, left, mid, less, p)
+		mergeSortHybrid(arr, mid+1, right, less, p)
+		mergeCopies(arr, left, mid, right, less, p)
+	}
+}
+
+// MergeSortNatural is natural merge sort (takes advantage of existing order).
+func MergeSortNatural[T cmp.Ordered](arr []T) {
+	mergeSortNatural(arr, cmp.Less[T], nil)
+}
+
+// MergeSortNaturalFunc is MergeSortNatural ordered by less.
+func MergeSortNaturalFunc[T any](arr []T, less func(a, b T) bool) {
+	mergeSortNatural(arr, less, nil)
+}
+
+func mergeSortNatural[T any](arr []T, less func(a, b T) bool, p *instrument.Probe) {
+	n := len(arr)
+
+	for {
+		runs := findRuns(arr, less, p)
+
+		// If only one run, array is sorted
+		if len(runs) <= 1 {
+			break
+		}
+
+		// Merge adjacent runs
+		i := 0
+		for i < len(runs)-1 {
+			left := runs[i]
+			mid := runs[i+1] - 1
+			right := n - 1
+			if i+2 < len(runs) {
+				right = runs[i+2] - 1
+			}
+
+			mergeCopies(arr, left, mid, right, less, p)
+			i += 2
+		}
+	}
+}
+
+// findRuns returns the start index of every ascending run in arr.
+func findRuns[T any](arr []T, less func(a, b T) bool, p *instrument.Probe) []int {
+	runs := []int{0}
+	n := len(arr)
+
+	for i := 1; i < n; i++ {
+		p.Compare(i, i-1)
+		if less(arr[i], arr[i-1]) {
+			runs = append(runs, i)
+		}
+	}
+
+	return runs
+}
+
+// MergeSortCustom is stable merge sort ordered by comp. It returns the
+// sorted elements in a new slice.
+func MergeSortCustom[T any](arr []T, comp comparator.Func[T]) []T {
+	return mergeSortBasic(arr, comp.Less, nil)
+}
+
+// MergeSortObjects stably sorts people by Age. It returns the sorted
+// elements in a new slice.
+func MergeSortObjects(arr []records.Person) []records.Person {
+	return mergeSortBasic(arr, func(a, b records.Person) bool {
+		return a.Age < b.Age
+	}, nil)
+}
@@ -0,0 +1,146 @@
+package sorting_test
+
+import (
+	"fmt"
+	"math/rand"
+	"runtime"
+	"slices"
+	"testing"
+
+	"github.com/RaviParvadiya/learn_dsa/gen"
+	"github.com/RaviParvadiya/learn_dsa/sorting"
+)
+
+// The conformance suite stays below the lengths from which the parallel
+// sorts fork, so these tests sort inputs long enough to reach every
+// parallel path, on one goroutine, on an odd number of them and on
+// GOMAXPROCS. Run them with -race.
+
+// parallelWorkers is the worker counts the parallel sorts are run with.
+func parallelWorkers() []int {
+	return []int{1, 3, runtime.GOMAXPROCS(0)}
+}
+
+// parallelDists is the distributions they sort: distinct values, many
+// duplicates, and the presorted orders that defeat a careless pivot.
+var parallelDists = []string{"random", "few-unique", "sorted", "reversed"}
+
+// parallelInputs calls f with every distribution at each of sizes.
+func parallelInputs(t *testing.T, sizes []int, f func(name string, input []int)) {
+	t.Helper()
+	for _, dist := range parallelDists {
+		for _, n := range sizes {
+			input, err := gen.Ints(dist, n, 4)
+			if err != nil {
+				t.Fatal(err)
+			}
+			f(fmt.Sprintf("%s/%d", dist, n), input)
+		}
+	}
+}
+
+// keyed is a value sorted by key alone, carrying its original position.
+type keyed struct {
+	key, pos int
+}
+
+// checkStableKeyed fails t unless arr is sorted by key with equal keys in
+// the order of their positions.
+func checkStableKeyed(t *testing.T, name string, arr []keyed) {
+	t.Helper()
+	for i := 1; i < len(arr); i++ {
+		a, b := arr[i-1], arr[i]
+		if b.key < a.key || b.key == a.key && b.pos < a.pos {
+			t.Errorf("%s: %v before %v at %d", name, a, b, i)
+			return
+		}
+	}
+}
+
+// withPositions pairs each value of input with its position.
+func withPositions(input []int) []keyed {
+	arr := make([]keyed, len(input))
+	for i, v := range input {
+		arr[i] = keyed{v, i}
+	}
+	return arr
+}
+
+// TestMergeSortParallel sorts runs above the merge grain, of 4096 elements,
+// both below it and far above it.
+func TestMergeSortParallel(t *testing.T) {
+	for _, w := range parallelWorkers() {
+		parallelInputs(t, []int{5000, 70000}, func(name string, input []int) {
+			got := slices.Clone(input)
+			sorting.MergeSortParallel(got, w)
+			checkSorted(t, fmt.Sprintf("%d workers/%s", w, name), input, got)
+		})
+	}
+}
+
+func TestMergeSortParallelFuncStable(t *testing.T) {
+	for _, w := range parallelWorkers() {
+		parallelInputs(t, []int{5000, 70000}, func(name string, input []int) {
+			arr := withPositions(input)
+			for i := range arr {
+				arr[i].key %= 100
+			}
+			sorting.MergeSortParallelFunc(arr, w, func(a, b keyed) bool { return a.key < b.key })
+			checkStableKeyed(t, fmt.Sprintf("%d workers/%s", w, name), arr)
+		})
+	}
+}
+
+// TestQuickSortParallel sorts ranges above the quicksort grain, of 8192
+// elements, and above the 131072 from which partitioning is split across
+// the pool, both sequentially and with the three-way split on duplicates.
+func TestQuickSortParallel(t *testing.T) {
+	for _, w := range parallelWorkers() {
+		parallelInputs(t, []int{10000, 300000}, func(name string, input []int) {
+			got := slices.Clone(input)
+			sorting.QuickSortParallel(got, w)
+			checkSorted(t, fmt.Sprintf("%d workers/%s", w, name), input, got)
+
+			arr := withPositions(input)
+			sorting.QuickSortParallelFunc(arr, w, func(a, b keyed) bool { return a.key < b.key })
+			keys := make([]int, len(arr))
+			for i, k := range arr {
+				keys[i] = k.key
+			}
+			checkSorted(t, fmt.Sprintf("%d workers/%s/func", w, name), input, keys)
+		})
+	}
+}
+
+// TestRadixSortParallel sorts enough keys that every worker gets a chunk
+// of at least the 65536 keys it takes to get one at all.
+func TestRadixSortParallel(t *testing.T) {
+	const chunk = 1 << 16
+	rng := rand.New(rand.NewSource(5))
+	for _, w := range parallelWorkers() {
+		n := chunk*w + chunk/2
+		wide := make([]uint64, n)
+		narrow := make([]uint32, n)
+		few := make([]uint64, n)
+		for i := range wide {
+			wide[i] = rng.Uint64()
+			narrow[i] = rng.Uint32()
+			// Keys differing only in their top byte skip the other passes.
+			few[i] = uint64(rng.Intn(4)) << 56
+		}
+		for name, keys := range map[string][]uint64{"uint64": wide, "top byte": few} {
+			want := slices.Clone(keys)
+			slices.Sort(want)
+			sorting.RadixSortParallel(keys, w)
+			if !slices.Equal(keys, want) {
+				t.Errorf("%d workers/%s: not sorted", w, name)
+			}
+		}
+		want := slices.Clone(narrow)
+		slices.Sort(want)
+		sorting.RadixSortParallel(narrow, w)
+		if !slices.Equal(narrow, want) {
+			t.Errorf("%d workers/uint32: not sorted", w)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package sorting
+
+import (
+	"cmp"
+	"sort"
+
+	"github.com/RaviParvadiya/learn_dsa/instrument"
+)
+
+const (
+	// parallelMergeGrain is the length below which the parallel merge sort
+	// sorts or merges a range on the current goroutine.
+	parallelMergeGrain = 1 << 12
+
+	// parallelMergeInsertion is the length below which it insertion sorts.
+	parallelMergeInsertion = 16
+)
+
+// MergeSortParallel is stable merge sort on a fixed pool of goroutines,
+// workers of them or GOMAXPROCS if workers <= 0. Both halves of a range
+// are sorted concurrently, and so are both halves of each large merge: it
+// is split in two by binary search for where the middle element of the
+// longer run falls in the shorter one.
+//
+// It allocates one buffer the size of arr and merges back and forth between
+// the two, so nothing is copied apart from the merges themselves.
+func MergeSortParallel[T cmp.Ordered](arr []T, workers int) {
+	mergeSortParallel(arr, workers, cmp.Less[T], nil)
+}
+
+// MergeSortParallelFunc is MergeSortParallel ordered by less, which must be
+// safe to call concurrently.
+func MergeSortParallelFunc[T any](arr []T, workers int, less func(a, b T) bool) {
+	mergeSortParallel(arr, workers, less, nil)
+}
+
+// mergeSortParallel is MergeSortParallel reporting to p, which must be nil
+// unless workers is 1, as a Probe is not safe for concurrent use.
+func mergeSortParallel[T any](arr []T, workers int, less func(a, b T) bool, p *instrument.Probe) {
+	if len(arr) <= parallelMergeInsertion {
+		insertionSort(arr, 0, len(arr)-1, less, p)
+		return
+	}
+	buf := make([]T, len(arr))
+	p.Alloc(len(buf))
+	pl := newPool(workers)
+	defer pl.close()
+	sortParallel(pl, arr, buf, 0, len(arr), false, less, p)
+}
+
+// sortParallel sorts the elements of arr[lo:hi], leaving them in buf[lo:hi]
+// if toBuf is set and in arr otherwise. The other slice is scratch. Only
+// the merges into arr are reported to p as writes, and with a Probe
+// nothing is forked.
+func sortParallel[T any](pl *pool, arr, buf []T, lo, hi int, toBuf bool, less func(a, b T) bool, p *instrument.Probe) {
+	n := hi - lo
+	if n <= parallelMergeInsertion {
+		insertionSort(arr, lo, hi-1, less, p)
+		if toBuf {
+			copy(buf[lo:hi], arr[lo:hi])
+		}
+		return
+	}
+	p.Enter()
+	defer p.Leave()
+
+	// Sort both halves into the slice that is not the destination, then
+	// merge them into the destination.
+	mid := lo + n/2
+	p.Split(lo, hi-1)
+	if p != nil || n < parallelMergeGrain {
+		sortParallel(pl, arr, buf, lo, mid, !toBuf, less, p)
+		sortParallel(pl, arr, buf, mid, hi, !toBuf, less, p)
+	} else {
+		g := pl.group()
+		g.fork(func() {
+			sortParallel(pl, arr, buf, lo, mid, !toBuf, less, p)
+		})
+		sortParallel(pl, arr, buf, mid, hi, !toBuf, less, p)
+		g.wait()
+	}
+
+	src, dst := buf, arr
+	if toBuf {
+		src, dst = arr, buf
+	}
+	p.Merge(lo, mid-1, hi-1)
+	if p != nil {
+		mergeInto(src[lo:mid], src[mid:hi], dst[lo:hi], lo, !toBuf, less, p)
+	} else {
+		mergeParallel(pl, src[lo:mid], src[mid:hi], dst[lo:hi], less)
+	}
+}
+
+// mergeParallel stably merges the sorted runs x and y, where x precedes y,
+// into dst.
+func mergeParallel[T any](p *pool, x, y, dst []T, less func(a, b T) bool) {
+	if len(x)+len(y) < parallelMergeGrain {
+		mergeInto(x, y, dst, 0, false, less, nil)
+		return
+	}
+
+	// Split the longer run at its middle and the other where that middle
+	// element would go, so that every element of the two left parts may
+	// precede every element of the right parts. Equal elements of x stay
+	// left of those of y.
+	var i, j int
+	if len(x) >= len(y) {
+		i = len(x) / 2
+		j = sort.Search(len(y), func(k int) bool { return !less(y[k], x[i]) })
+	} else {
+		j = len(y) / 2
+		i = sort.Search(len(x), func(k int) bool { return less(y[j], x[k]) })
+	}
+
+	g := p.group()
+	g.fork(func() {
+		mergeParallel(p, x[:i], y[:j], dst[:i+j], less)
+	})
+	mergeParallel(p, x[i:], y[j:], dst[i+j:], less)
+	g.wait()
+}
+
+// mergeInto stably merges the sorted runs x and y, where x precedes y,
+// into dst. The runs are reported to p as the positions from lo on, and
+// dst as those positions if toArr is set; otherwise its writes go to
+// scratch and are not reported.
+func mergeInto[T any](x, y, dst []T, lo int, toArr bool, less func(a, b T) bool, p *instrument.Probe) {
+	i, j, k := 0, 0, 0
+	for i < len(x) && j < len(y) {
+		p.Compare(lo+len(x)+j, lo+i)
+		if less(y[j], x[i]) {
+			dst[k] = y[j]
+			j++
+		} else {
+			dst[k] = x[i]
+			i++
+		}
+		if toArr {
+			p.Write(lo + k)
+		}
+		k++
+	}
+	for _, v := range x[i:] {
+		dst[k] = v
+		if toArr {
+			p.Write(lo + k)
+		}
+		k++
+	}
+	for _, v := range y[j:] {
+		dst[k] = v
+		if toArr {
+			p.Write(lo + k)
+		}
+		k++
+	}
+}
@@ -0,0 +1,223 @@
+package sorting
+
+import (
+	"cmp"
+	"math/bits"
+
+	"github.com/RaviParvadiya/learn_dsa/instrument"
+)
+
+const (
+	// parallelQuickGrain is the length below which the parallel quicksort
+	// finishes a range with introsort on the current goroutine.
+	parallelQuickGrain = 1 << 13
+
+	// parallelPartitionMin is the length from which a range is partitioned
+	// by several goroutines, each taking chunks of at least
+	// parallelPartitionChunk elements.
+	parallelPartitionMin   = 1 << 17
+	parallelPartitionChunk = 1 << 15
+)
+
+// QuickSortParallel is introsort on a fixed pool of goroutines, workers of
+// them or GOMAXPROCS if workers <= 0. Once a range is partitioned, one side
+// is handed to an idle goroutine while the current one goes on with the
+// other, and ranges shorter than parallelQuickGrain are sorted sequentially.
+//
+// Partitioning a long range is itself split across the pool: each
+// goroutine partitions a chunk, then the elements left on the wrong side
+// of the overall split are swapped across in parallel. The pivot is a
+// median of three, and runs of elements equal to it are set aside with a
+// three-way partition when the samples repeat.
+func QuickSortParallel[T cmp.Ordered](arr []T, workers int) {
+	quickSortParallel(arr, workers, cmp.Less[T], nil)
+}
+
+// QuickSortParallelFunc is QuickSortParallel ordered by less, which must be
+// safe to call concurrently.
+func QuickSortParallelFunc[T any](arr []T, workers int, less func(a, b T) bool) {
+	quickSortParallel(arr, workers, less, nil)
+}
+
+// quickSortParallel is QuickSortParallel reporting to p, which must be nil
+// unless workers is 1, as a Probe is not safe for concurrent use.
+func quickSortParallel[T any](arr []T, workers int, less func(a, b T) bool, p *instrument.Probe) {
+	if len(arr) < parallelQuickGrain {
+		introsort(arr, less, p)
+		return
+	}
+	pl := newPool(workers)
+	defer pl.close()
+	quickSortParallelRange(pl, arr, 0, len(arr)-1, 2*bits.Len(uint(len(arr))), less, p)
+}
+
+// quickSortParallelRange sorts arr[low..high], forking the left side of
+// each partition and looping on the right one.
+func quickSortParallelRange[T any](pl *pool, arr []T, low, high, depth int, less func(a, b T) bool, p *instrument.Probe) {
+	p.Enter()
+	defer p.Leave()
+	g := pl.group()
+	defer g.wait()
+
+	for high-low+1 >= parallelQuickGrain {
+		if depth == 0 {
+			heapSort(arr, low, high, less, p)
+			return
+		}
+		depth--
+
+		// The left part is arr[low..leftHigh] and the right one
+		// arr[rightLow..high].
+		var leftHigh, rightLow int
+		p.Split(low, high)
+		dup := medianToLow(arr, low, high, less, p)
+		switch {
+		case high-low+1 >= parallelPartitionMin:
+			lt, gt := partitionParallel(pl, arr, low, high+1, dup, less, p)
+			leftHigh, rightLow = lt-1, gt+1
+		case dup:
+			lt, gt := partitionThreeWay(arr, low, high, less, p)
+			leftHigh, rightLow = lt-1, gt+1
+		default:
+			pi := partitionHoare(arr, low, high, less, p)
+			leftHigh, rightLow = pi, pi+1
+		}
+
+		left, d := low, depth
+		g.fork(func() {
+			quickSortParallelRange(pl, arr, left, leftHigh, d, less, p)
+		})
+		low = rightLow
+	}
+	introsortRange(arr, low, high, depth, less, p)
+}
+
+// partitionParallel partitions arr[lo:hi] around arr[lo] on the pool and
+// returns lt and gt such that arr[lo:lt] is less than the pivot and
+// arr[gt+1:hi] is no less. With threeWay set arr[lt..gt] holds the elements
+// equal to the pivot, so arr[gt+1:hi] is greater; otherwise gt = lt-1.
+func partitionParallel[T any](pl *pool, arr []T, lo, hi int, threeWay bool, less func(a, b T) bool, p *instrument.Probe) (int, int) {
+	pivot := arr[lo]
+	lt := lo + partitionByParallel(pl, arr, lo, hi, func(x T) bool { return less(x, pivot) }, p)
+	if !threeWay {
+		return lt, lt - 1
+	}
+	eq := partitionByParallel(pl, arr, lt, hi, func(x T) bool { return !less(pivot, x) }, p)
+	return lt, lt + eq - 1
+}
+
+// span is the half-open range of indices [lo, hi).
+type span struct{ lo, hi int }
+
+// partitionByParallel moves the elements of arr[lo:hi] for which pred
+// holds before the others and returns how many there are. Each goroutine
+// of the pool partitions a chunk of it; every chunk then has some elements
+// on the wrong side of the overall split, and as many belong on one side
+// as on the other, so they are swapped pairwise, again split across the
+// pool. Only a pool of one goroutine, which partitions sequentially,
+// reports to p.
+func partitionByParallel[T any](pl *pool, arr []T, lo, hi int, pred func(T) bool, p *instrument.Probe) int {
+	n := hi - lo
+	chunks := min(pl.size, n/parallelPartitionChunk)
+	if chunks <= 1 {
+		return partitionBy(arr, lo, hi, pred, p)
+	}
+	arr = arr[lo:hi]
+
+	size := (n + chunks - 1) / chunks
+	parts := make([]span, chunks) // parts[c].lo is chunk c's start, hi its split
+	g := pl.group()
+	for c := range parts {
+		lo, hi := c*size, min((c+1)*size, n)
+		g.fork(func() {
+			parts[c] = span{lo, lo + partitionBy(arr, lo, hi, pred, nil)}
+		})
+	}
+	g.wait()
+
+	split := 0
+	for _, s := range parts {
+		split += s.hi - s.lo
+	}
+
+	// Collect the runs of elements failing pred left of split and of those
+	// satisfying it right of split.
+	var fail, pass []span
+	total := 0
+	for c, s := range parts {
+		end := min((c+1)*size, n)
+		if s.hi < split {
+			fail = append(fail, span{s.hi, min(end, split)})
+			total += min(end, split) - s.hi
+		}
+		if s.hi > split {
+			pass = append(pass, span{max(s.lo, split), s.hi})
+		}
+	}
+
+	per := (total + chunks - 1) / chunks
+	g = pl.group()
+	for from := 0; from < total; from += per {
+		g.fork(func() {
+			swapSpans(arr, fail, pass, from, min(from+per, total))
+		})
+	}
+	g.wait()
+	return split
+}
+
+// swapSpans swaps the elements at offsets [from, to) of the runs xs with
+// those at the same offsets of the runs ys, counting offsets across the
+// runs in order.
+func swapSpans[T any](arr []T, xs, ys []span, from, to int) {
+	// seek returns the run and index at offset k of runs.
+	seek := func(runs []span, k int) (int, int) {
+		r := 0
+		for k >= runs[r].hi-runs[r].lo {
+			k -= runs[r].hi - runs[r].lo
+			r++
+		}
+		return r, runs[r].lo + k
+	}
+	xr, i := seek(xs, from)
+	yr, j := seek(ys, from)
+	for k := from; k < to; k++ {
+		for i == xs[xr].hi {
+			xr++
+			i = xs[xr].lo
+		}
+		for j == ys[yr].hi {
+			yr++
+			j = ys[yr].lo
+		}
+		arr[i], arr[j] = arr[j], arr[i]
+		i++
+		j++
+	}
+}
+
+// partitionBy moves the elements of arr[lo:hi] for which pred holds before
+// the others and returns how many there are. pred is recorded as a
+// comparison with the pivot, which is not a position.
+func partitionBy[T any](arr []T, lo, hi int, pred func(T) bool, p *instrument.Probe) int {
+	i, j := lo, hi-1
+	for {
+		for i <= j && pred(arr[i]) {
+			p.Compare(i, -1)
+			i++
+		}
+		for i <= j && !pred(arr[j]) {
+			p.Compare(j, -1)
+			j--
+		}
+		if i >= j {
+			return i - lo
+		}
+		p.Compare(i, -1)
+		p.Compare(j, -1)
+		arr[i], arr[j] = arr[j], arr[i]
+		p.Swap(i, j)
+		i++
+		j--
+	}
+}
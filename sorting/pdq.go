@@ -0,0 +1,277 @@
+package sorting
+
+import (
+	"cmp"
+	"math/bits"
+
+	"github.com/RaviParvadiya/learn_dsa/instrument"
+)
+
+// Pdqsort is pattern-defeating quicksort. It builds on QuicksortHybrid,
+// leaving small ranges to insertion sort, and adds:
+//   - ninther (median of medians) pivot selection on large ranges;
+//   - a cheap insertion pass that finishes ranges found already sorted,
+//     and reversal of ranges found descending;
+//   - three-way partitioning once a pivot repeats, so runs of duplicates
+//     are set aside in one pass;
+//   - shuffling a few elements after an unbalanced partition to break
+//     adversarial patterns, and heapsort if that keeps happening.
+//
+// It is O(n log n) in the worst case and close to O(n) on sorted, reversed
+// and few-unique input.
+func Pdqsort[T cmp.Ordered](arr []T) {
+	pdqsort(arr, cmp.Less[T], nil)
+}
+
+// PdqsortFunc is Pdqsort ordered by less.
+func PdqsortFunc[T any](arr []T, less func(a, b T) bool) {
+	pdqsort(arr, less, nil)
+}
+
+// pivotHint is what choosePivot learned about the order of its samples.
+type pivotHint int
+
+const (
+	unknownHint pivotHint = iota
+	increasingHint
+	decreasingHint
+)
+
+func pdqsort[T any](arr []T, less func(a, b T) bool, p *instrument.Probe) {
+	pdqsortRange(arr, 0, len(arr), bits.Len(uint(len(arr))), less, p)
+}
+
+// pdqsortRange sorts arr[a:b], allowing limit unbalanced partitions before
+// falling back to heapsort.
+func pdqsortRange[T any](arr []T, a, b, limit int, less func(a, b T) bool, p *instrument.Probe) {
+	p.Enter()
+	defer p.Leave()
+
+	const maxInsertion = 12
+
+	wasBalanced, wasPartitioned := true, true
+	for {
+		length := b - a
+		if length <= maxInsertion {
+			insertionSort(arr, a, b-1, less, p)
+			return
+		}
+		if limit == 0 {
+			heapSort(arr, a, b-1, less, p)
+			return
+		}
+		if !wasBalanced {
+			breakPatterns(arr, a, b, p)
+			limit--
+		}
+
+		p.Split(a, b-1)
+		pivot, hint := choosePivot(arr, a, b, less, p)
+		if hint == decreasingHint {
+			reverseRange(arr, a, b, p)
+			pivot = (b - 1) - (pivot - a)
+			hint = increasingHint
+		}
+
+		// The last partition was clean and the samples were in order: the
+		// range may well be sorted already.
+		if wasBalanced && wasPartitioned && hint == increasingHint {
+			if partialInsertionSort(arr, a, b, less, p) {
+				return
+			}
+		}
+
+		// arr[a-1] is a previous pivot, no greater than anything here. If
+		// the new pivot equals it, the range starts with a run of
+		// duplicates, which three-way partitioning sets aside at once.
+		if a > 0 {
+			p.Compare(a-1, pivot)
+			if !less(arr[a-1], arr[pivot]) {
+				arr[a], arr[pivot] = arr[pivot], arr[a]
+				p.Swap(a, pivot)
+				_, gt := partitionThreeWay(arr, a, b-1, less, p)
+				a = gt + 1
+				continue
+			}
+		}
+
+		mid, alreadyPartitioned := partitionPdq(arr, a, b, pivot, less, p)
+		wasPartitioned = alreadyPartitioned
+
+		// Recurse into the smaller side and loop on the larger one.
+		leftLen, rightLen := mid-a, b-mid
+		balanceThreshold := length / 8
+		if leftLen < rightLen {
+			wasBalanced = leftLen >= balanceThreshold
+			pdqsortRange(arr, a, mid, limit, less, p)
+			a = mid + 1
+		} else {
+			wasBalanced = rightLen >= balanceThreshold
+			pdqsortRange(arr, mid+1, b, limit, less, p)
+			b = mid
+		}
+	}
+}
+
+// partitionPdq partitions arr[a:b] around arr[pivot] and returns the
+// pivot's final position, reporting whether no elements had to be swapped.
+func partitionPdq[T any](arr []T, a, b, pivot int, less func(a, b T) bool, p *instrument.Probe) (int, bool) {
+	arr[a], arr[pivot] = arr[pivot], arr[a]
+	p.Swap(a, pivot)
+	i, j := a+1, b-1 // arr[i..j] is still to be partitioned
+
+	scan := func() {
+		for i <= j {
+			p.Compare(i, a)
+			if !less(arr[i], arr[a]) {
+				break
+			}
+			i++
+		}
+		for i <= j {
+			p.Compare(j, a)
+			if less(arr[j], arr[a]) {
+				break
+			}
+			j--
+		}
+	}
+
+	scan()
+	if i > j {
+		arr[j], arr[a] = arr[a], arr[j]
+		p.Swap(j, a)
+		return j, true
+	}
+	for i <= j {
+		arr[i], arr[j] = arr[j], arr[i]
+		p.Swap(i, j)
+		i++
+		j--
+		scan()
+	}
+	arr[j], arr[a] = arr[a], arr[j]
+	p.Swap(j, a)
+	return j, false
+}
+
+// partialInsertionSort tries to finish sorting arr[a:b] by fixing a few
+// out-of-order elements, and reports whether it succeeded.
+func partialInsertionSort[T any](arr []T, a, b int, less func(a, b T) bool, p *instrument.Probe) bool {
+	const (
+		maxSteps         = 5  // most out-of-order pairs to fix
+		shortestShifting = 50 // don't shift elements in shorter ranges
+	)
+	// inOrder reports whether arr[k-1] <= arr[k].
+	inOrder := func(k int) bool {
+		p.Compare(k, k-1)
+		return !less(arr[k], arr[k-1])
+	}
+
+	i := a + 1
+	for step := 0; step < maxSteps; step++ {
+		for i < b && inOrder(i) {
+			i++
+		}
+		if i == b {
+			return true
+		}
+		if b-a < shortestShifting {
+			return false
+		}
+		arr[i], arr[i-1] = arr[i-1], arr[i]
+		p.Swap(i, i-1)
+
+		// Shift the smaller one left and the greater one right.
+		for j := i - 1; j > a && !inOrder(j); j-- {
+			arr[j], arr[j-1] = arr[j-1], arr[j]
+			p.Swap(j, j-1)
+		}
+		for j := i + 1; j < b && !inOrder(j); j++ {
+			arr[j], arr[j-1] = arr[j-1], arr[j]
+			p.Swap(j, j-1)
+		}
+	}
+	return false
+}
+
+// breakPatterns swaps three elements near the middle of arr[a:b] with
+// pseudo-random others, so that inputs crafted against the pivot choice
+// stop partitioning badly.
+func breakPatterns[T any](arr []T, a, b int, p *instrument.Probe) {
+	length := b - a
+	if length < 8 {
+		return
+	}
+	random := uint64(length) // xorshift state, seeded by the length for reproducibility
+	mask := uint64(1)<<bits.Len(uint(length)) - 1
+	idx := a + (length/4)*2 - 1
+	for i := 0; i < 3; i++ {
+		random ^= random << 13
+		random ^= random >> 7
+		random ^= random << 17
+		other := int(random & mask)
+		if other >= length {
+			other -= length
+		}
+		arr[idx-1+i], arr[a+other] = arr[a+other], arr[idx-1+i]
+		p.Swap(idx-1+i, a+other)
+	}
+}
+
+// choosePivot picks a pivot for arr[a:b]: the median of three samples, each
+// itself the median of three neighbours on long ranges (the ninther). The
+// hint says whether the samples were all in increasing or all in decreasing
+// order.
+func choosePivot[T any](arr []T, a, b int, less func(a, b T) bool, p *instrument.Probe) (int, pivotHint) {
+	const (
+		shortestNinther = 50
+		maxSwaps        = 4 * 3
+	)
+	l := b - a
+	swaps := 0
+	i, j, k := a+l/4*1, a+l/4*2, a+l/4*3
+
+	// median returns the index holding the median of arr[x], arr[y] and
+	// arr[z], counting the out-of-order pairs met in swaps.
+	median := func(x, y, z int) int {
+		order := func(x, y int) (int, int) {
+			p.Compare(y, x)
+			if less(arr[y], arr[x]) {
+				swaps++
+				return y, x
+			}
+			return x, y
+		}
+		x, y = order(x, y)
+		y, z = order(y, z)
+		_, y = order(x, y)
+		return y
+	}
+
+	if l >= 8 {
+		if l >= shortestNinther {
+			i = median(i-1, i, i+1)
+			j = median(j-1, j, j+1)
+			k = median(k-1, k, k+1)
+		}
+		j = median(i, j, k)
+	}
+
+	switch swaps {
+	case 0:
+		return j, increasingHint
+	case maxSwaps:
+		return j, decreasingHint
+	default:
+		return j, unknownHint
+	}
+}
+
+// reverseRange reverses arr[a:b].
+func reverseRange[T any](arr []T, a, b int, p *instrument.Probe) {
+	for i, j := a, b-1; i < j; i, j = i+1, j-1 {
+		arr[i], arr[j] = arr[j], arr[i]
+		p.Swap(i, j)
+	}
+}
@@ -0,0 +1,89 @@
+package sorting
+
+import (
+	"runtime"
+	"sync/atomic"
+)
+
+// pool runs tasks on a fixed set of goroutines: the workers and the
+// goroutine that started the sort. A task is handed over only if some
+// goroutine is idle and ready to take it; otherwise the forking goroutine
+// runs it itself, so no queue builds up and the number of goroutines
+// working never exceeds the pool size.
+type pool struct {
+	size  int
+	tasks chan func()
+}
+
+// newPool starts a pool of the given size, or of GOMAXPROCS if size <= 0.
+// The calling goroutine counts as one of them, so size-1 workers are
+// started. The pool must be closed when done.
+func newPool(size int) *pool {
+	if size <= 0 {
+		size = runtime.GOMAXPROCS(0)
+	}
+	p := &pool{size: size, tasks: make(chan func())}
+	for i := 1; i < size; i++ {
+		go func() {
+			for task := range p.tasks {
+				task()
+			}
+		}()
+	}
+	return p
+}
+
+// close stops the workers once they finish their current tasks.
+func (p *pool) close() {
+	close(p.tasks)
+}
+
+// group is a set of tasks forked on a pool and waited for together.
+// It can be waited for only once.
+type group struct {
+	pool    *pool
+	pending atomic.Int64 // forked tasks not yet finished, plus one until wait
+	done    chan struct{}
+}
+
+func (p *pool) group() *group {
+	g := &group{pool: p, done: make(chan struct{})}
+	g.pending.Store(1)
+	return g
+}
+
+// fork runs task on an idle goroutine of the pool, or right away if there
+// is none.
+func (g *group) fork(task func()) {
+	g.pending.Add(1)
+	run := func() {
+		defer g.release()
+		task()
+	}
+	select {
+	case g.pool.tasks <- run:
+	default:
+		run()
+	}
+}
+
+// wait returns once every forked task has finished. Meanwhile it runs
+// tasks forked elsewhere in the pool, so a waiting goroutine is never idle
+// and nested forks cannot deadlock.
+func (g *group) wait() {
+	g.release()
+	for {
+		select {
+		case <-g.done:
+			return
+		case task := <-g.pool.tasks:
+			task()
+		}
+	}
+}
+
+func (g *group) release() {
+	if g.pending.Add(-1) == 0 {
+		close(g.done)
+	}
+}
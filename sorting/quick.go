@@ -0,0 +1,481 @@
+package sorting
+
+import (
+	"cmp"
+	"math/rand"
+
+	"github.com/RaviParvadiya/learn_dsa/instrument"
+)
+
+// QuickSort sorts arr with introsort: quicksort around a median-of-three
+// pivot that falls back to heapsort when the recursion runs too deep and
+// finishes small ranges with insertion sort. Unlike the variants below it is
+// O(n log n) even on sorted or adversarial input.
+func QuickSort[T cmp.Ordered](arr []T) {
+	introsort(arr, cmp.Less[T], nil)
+}
+
+// QuickSortFunc is QuickSort ordered by less.
+func QuickSortFunc[T any](arr []T, less func(a, b T) bool) {
+	introsort(arr, less, nil)
+}
+
+// QuicksortLomuto is quicksort with the Lomuto partition scheme.
+func QuicksortLomuto[T cmp.Ordered](arr []T, low, high int) {
+	quicksortLomuto(arr, low, high, cmp.Less[T], nil)
+}
+
+// QuicksortLomutoFunc is QuicksortLomuto ordered by less.
+func QuicksortLomutoFunc[T any](arr []T, low, high int, less func(a, b T) bool) {
+	quicksortLomuto(arr, low, high, less, nil)
+}
+
+func quicksortLomuto[T any](arr []T, low, high int, less func(a, b T) bool, p *instrument.Probe) {
+	p.Enter()
+	defer p.Leave()
+
+	if low < high {
+		p.Split(low, high)
+		pi := partitionLomuto(arr, low, high, less, p)
+		quicksortLomuto(arr, low, pi-1, less, p)
+		quicksortLomuto(arr, pi+1, high, less, p)
+	}
+}
+
+func partitionLomuto[T any](arr []T, low, high int, less func(a, b T) bool, p *instrument.Probe) int {
+	pivot := arr[high]
+	i := low - 1
+
+	for j := low; j < high; j++ {
+		p.Compare(j, high)
+		if less(arr[j], pivot) {
+			i++
+			if j != i { // Optional prevent self swap
+				arr[i], arr[j] = arr[j], arr[i]
+				p.Swap(i, j)
+			}
+		}
+	}
+	arr[i+1], arr[high] = arr[high], arr[i+1]
+	p.Swap(i+1, high)
+	return i + 1
+}
+
+// QuicksortHoare is quicksort with the original Hoare partition scheme
+// (pivot at low).
+func QuicksortHoare[T cmp.Ordered](arr []T, low, high int) {
+	quicksortHoare(arr, low, high, cmp.Less[T], nil)
+}
+
+// QuicksortHoareFunc is QuicksortHoare ordered by less.
+func QuicksortHoareFunc[T any](arr []T, low, high int, less func(a, b T) bool) {
+	quicksortHoare(arr, low, high, less, nil)
+}
+
+func quicksortHoare[T any](arr []T, low, high int, less func(a, b T) bool, p *instrument.Probe) {
+	p.Enter()
+	defer p.Leave()
+
+	if low < high {
+		p.Split(low, high)
+		pi := partitionHoare(arr, low, high, less, p)
+		quicksortHoare(arr, low, pi, less, p)
+		quicksortHoare(arr, pi+1, high, less, p)
+	}
+}
+
+func partitionHoare[T any](arr []T, low, high int, less func(a, b T) bool, p *instrument.Probe) int {
+	pivot := arr[low]
+	i := low - 1
+	j := high + 1
+
+	for {
+		for {
+			i++
+			p.Compare(i, -1)
+			if !less(arr[i], pivot) {
+				break
+			}
+		}
+		for {
+			j--
+			p.Compare(-1, j)
+			if !less(pivot, arr[j]) {
+				break
+			}
+		}
+		if i >= j {
+			return j
+		}
+		arr[i], arr[j] = arr[j], arr[i]
+		p.Swap(i, j)
+	}
+}
+
+// QuicksortHoareLastPivot is quicksort with a Hoare partition modified to
+// use the last element as the pivot.
+func QuicksortHoareLastPivot[T cmp.Ordered](arr []T, low, high int) {
+	quicksortHoareLastPivot(arr, low, high, cmp.Less[T], nil)
+}
+
+// QuicksortHoareLastPivotFunc is QuicksortHoareLastPivot ordered by less.
+func QuicksortHoareLastPivotFunc[T any](arr []T, low, high int, less func(a, b T) bool) {
+	quicksortHoareLastPivot(arr, low, high, less, nil)
+}
+
+func quicksortHoareLastPivot[T any](arr []T, low, high int, less func(a, b T) bool, p *instrument.Probe) {
+	p.Enter()
+	defer p.Leave()
+
+	if low < high {
+		p.Split(low, high)
+		pi := partitionHoareLastPivot(arr, low, high, less, p)
+		quicksortHoareLastPivot(arr, low, pi-1, less, p)
+		quicksortHoareLastPivot(arr, pi, high, less, p)
+	}
+}
+
+// partitionHoareLastPivot returns the start of the right part. With the
+// pivot taken from high the split has to be made at i rather than j,
+// otherwise a maximal pivot leaves the right part empty and the recursion
+// never shrinks.
+func partitionHoareLastPivot[T any](arr []T, low, high int, less func(a, b T) bool, p *instrument.Probe) int {
+	pivot := arr[high] // Use last element as pivot
+	i := low - 1
+	j := high + 1
+
+	for {
+		for {
+			i++
+			p.Compare(i, -1)
+			if !less(arr[i], pivot) {
+				break
+			}
+		}
+		for {
+			j--
+			p.Compare(-1, j)
+			if !less(pivot, arr[j]) {
+				break
+			}
+		}
+		if i >= j {
+			return i
+		}
+		arr[i], arr[j] = arr[j], arr[i]
+		p.Swap(i, j)
+	}
+}
+
+// QuicksortHoareMidPivot is quicksort with a Hoare partition modified to use
+// the middle element as the pivot.
+func QuicksortHoareMidPivot[T cmp.Ordered](arr []T, low, high int) {
+	quicksortHoareMidPivot(arr, low, high, cmp.Less[T], nil)
+}
+
+// QuicksortHoareMidPivotFunc is QuicksortHoareMidPivot ordered by less.
+func QuicksortHoareMidPivotFunc[T any](arr []T, low, high int, less func(a, b T) bool) {
+	quicksortHoareMidPivot(arr, low, high, less, nil)
+}
+
+func quicksortHoareMidPivot[T any](arr []T, low, high int, less func(a, b T) bool, p *instrument.Probe) {
+	p.Enter()
+	defer p.Leave()
+
+	if low < high {
+		p.Split(low, high)
+		pi := partitionHoareMidPivot(arr, low, high, less, p)
+		quicksortHoareMidPivot(arr, low, pi, less, p)
+		quicksortHoareMidPivot(arr, pi+1, high, less, p)
+	}
+}
+
+func partitionHoareMidPivot[T any](arr []T, low, high int, less func(a, b T) bool, p *instrument.Probe) int {
+	mid := low + (high-low)/2
+	pivot := arr[mid]                       // Use mid element as pivot
+	arr[mid], arr[low] = arr[low], arr[mid] // Move pivot to start
+	p.Swap(mid, low)
+
+	i := low - 1
+	j := high + 1
+
+	for {
+		for {
+			i++
+			p.Compare(i, -1)
+			if !less(arr[i], pivot) {
+				break
+			}
+		}
+		for {
+			j--
+			p.Compare(-1, j)
+			if !less(pivot, arr[j]) {
+				break
+			}
+		}
+		if i >= j {
+			return j
+		}
+		arr[i], arr[j] = arr[j], arr[i]
+		p.Swap(i, j)
+	}
+}
+
+// QuicksortThreeWay is three-way (Dutch National Flag) quicksort.
+func QuicksortThreeWay[T cmp.Ordered](arr []T, low, high int) {
+	quicksortThreeWay(arr, low, high, cmp.Less[T], nil)
+}
+
+// QuicksortThreeWayFunc is QuicksortThreeWay ordered by less.
+func QuicksortThreeWayFunc[T any](arr []T, low, high int, less func(a, b T) bool) {
+	quicksortThreeWay(arr, low, high, less, nil)
+}
+
+func quicksortThreeWay[T any](arr []T, low, high int, less func(a, b T) bool, p *instrument.Probe) {
+	p.Enter()
+	defer p.Leave()
+
+	if low < high {
+		p.Split(low, high)
+		lt, gt := partitionThreeWay(arr, low, high, less, p)
+		quicksortThreeWay(arr, low, lt-1, less, p)
+		quicksortThreeWay(arr, gt+1, high, less, p)
+	}
+}
+
+func partitionThreeWay[T any](arr []T, low, high int, less func(a, b T) bool, p *instrument.Probe) (int, int) {
+	pivot := arr[low]
+	lt := low
+	gt := high
+	i := low
+
+	for i <= gt {
+		p.Compare(i, -1)
+		if less(arr[i], pivot) {
+			arr[lt], arr[i] = arr[i], arr[lt]
+			p.Swap(lt, i)
+			lt++
+			i++
+			continue
+		}
+		p.Compare(-1, i)
+		if less(pivot, arr[i]) {
+			arr[i], arr[gt] = arr[gt], arr[i]
+			p.Swap(i, gt)
+			gt--
+		} else {
+			i++
+		}
+	}
+	return lt, gt
+}
+
+// QuicksortRandomized is quicksort with a random pivot.
+func QuicksortRandomized[T cmp.Ordered](arr []T, low, high int) {
+	quicksortRandomized(arr, low, high, cmp.Less[T], nil)
+}
+
+// QuicksortRandomizedFunc is QuicksortRandomized ordered by less.
+func QuicksortRandomizedFunc[T any](arr []T, low, high int, less func(a, b T) bool) {
+	quicksortRandomized(arr, low, high, less, nil)
+}
+
+func quicksortRandomized[T any](arr []T, low, high int, less func(a, b T) bool, p *instrument.Probe) {
+	p.Enter()
+	defer p.Leave()
+
+	if low < high {
+		p.Split(low, high)
+		pi := partitionRandomized(arr, low, high, less, p)
+		quicksortRandomized(arr, low, pi-1, less, p)
+		quicksortRandomized(arr, pi+1, high, less, p)
+	}
+}
+
+func partitionRandomized[T any](arr []T, low, high int, less func(a, b T) bool, p *instrument.Probe) int {
+	// Random pivot selection
+	randomIndex := low + rand.Intn(high-low+1)
+	arr[randomIndex], arr[high] = arr[high], arr[randomIndex]
+	p.Swap(randomIndex, high)
+
+	// Use Lomuto partition with random pivot
+	pivot := arr[high]
+	i := low - 1
+
+	for j := low; j < high; j++ {
+		p.Compare(j, high)
+		if less(arr[j], pivot) {
+			i++
+			arr[i], arr[j] = arr[j], arr[i]
+			p.Swap(i, j)
+		}
+	}
+	arr[i+1], arr[high] = arr[high], arr[i+1]
+	p.Swap(i+1, high)
+	return i + 1
+}
+
+// QuicksortIterative is quicksort with an explicit stack instead of recursion.
+func QuicksortIterative[T cmp.Ordered](arr []T, low, high int) {
+	quicksortIterative(arr, low, high, cmp.Less[T], nil)
+}
+
+// QuicksortIterativeFunc is QuicksortIterative ordered by less.
+func QuicksortIterativeFunc[T any](arr []T, low, high int, less func(a, b T) bool) {
+	quicksortIterative(arr, low, high, less, nil)
+}
+
+func quicksortIterative[T any](arr []T, low, high int, less func(a, b T) bool, p *instrument.Probe) {
+	if low >= high {
+		return
+	}
+	stack := make([]int, high-low+1)
+	p.Alloc(len(stack))
+	top := -1
+
+	// Push initial values
+	top++
+	stack[top] = low
+	top++
+	stack[top] = high
+	p.Enter()
+
+	for top >= 0 {
+		// Pop high and low
+		high = stack[top]
+		top--
+		low = stack[top]
+		top--
+		p.Leave()
+
+		// Partition
+		p.Split(low, high)
+		pi := partitionLomuto(arr, low, high, less, p)
+
+		// Push left side to stack
+		if pi-1 > low {
+			top++
+			stack[top] = low
+			top++
+			stack[top] = pi - 1
+			p.Enter()
+		}
+
+		// Push right side to stack
+		if pi+1 < high {
+			top++
+			stack[top] = pi + 1
+			top++
+			stack[top] = high
+			p.Enter()
+		}
+	}
+}
+
+// QuicksortHybrid is quicksort that finishes small ranges with insertion sort.
+func QuicksortHybrid[T cmp.Ordered](arr []T, low, high int) {
+	quicksortHybrid(arr, low, high, cmp.Less[T], nil)
+}
+
+// QuicksortHybridFunc is QuicksortHybrid ordered by less.
+func QuicksortHybridFunc[T any](arr []T, low, high int, less func(a, b T) bool) {
+	quicksortHybrid(arr, low, high, less, nil)
+}
+
+func quicksortHybrid[T any](arr []T, low, high int, less func(a, b T) bool, p *instrument.Probe) {
+	p.Enter()
+	defer p.Leave()
+
+	const threshold = 10
+
+	if high-low < threshold {
+		insertionSort(arr, low, high, less, p)
+		return
+	}
+
+	if low < high {
+		p.Split(low, high)
+		pi := partitionLomuto(arr, low, high, less, p)
+		quicksortHybrid(arr, low, pi-1, less, p)
+		quicksortHybrid(arr, pi+1, high, less, p)
+	}
+}
+
+// QuicksortDualPivot is dual-pivot quicksort.
+func QuicksortDualPivot[T cmp.Ordered](arr []T, low, high int) {
+	quicksortDualPivot(arr, low, high, cmp.Less[T], nil)
+}
+
+// QuicksortDualPivotFunc is QuicksortDualPivot ordered by less.
+func QuicksortDualPivotFunc[T any](arr []T, low, high int, less func(a, b T) bool) {
+	quicksortDualPivot(arr, low, high, less, nil)
+}
+
+func quicksortDualPivot[T any](arr []T, low, high int, less func(a, b T) bool, p *instrument.Probe) {
+	p.Enter()
+	defer p.Leave()
+
+	if low < high {
+		p.Split(low, high)
+		lp, rp := partitionDualPivot(arr, low, high, less, p)
+		quicksortDualPivot(arr, low, lp-1, less, p)
+		quicksortDualPivot(arr, lp+1, rp-1, less, p)
+		quicksortDualPivot(arr, rp+1, high, less, p)
+	}
+}
+
+func partitionDualPivot[T any](arr []T, low, high int, less func(a, b T) bool, p *instrument.Probe) (int, int) {
+	p.Compare(high, low)
+	if less(arr[high], arr[low]) {
+		arr[low], arr[high] = arr[high], arr[low]
+		p.Swap(low, high)
+	}
+
+	lp := arr[low]
+	rp := arr[high]
+
+	lt := low + 1
+	gt := high - 1
+	i := low + 1
+
+	for i <= gt {
+		p.Compare(i, low)
+		if less(arr[i], lp) {
+			arr[i], arr[lt] = arr[lt], arr[i]
+			p.Swap(i, lt)
+			lt++
+		} else {
+			p.Compare(i, high)
+			if !less(arr[i], rp) {
+				for i < gt {
+					p.Compare(high, gt)
+					if !less(rp, arr[gt]) {
+						break
+					}
+					gt--
+				}
+				arr[i], arr[gt] = arr[gt], arr[i]
+				p.Swap(i, gt)
+				gt--
+				p.Compare(i, low)
+				if less(arr[i], lp) {
+					arr[i], arr[lt] = arr[lt], arr[i]
+					p.Swap(i, lt)
+					lt++
+				}
+			}
+		}
+		i++
+	}
+
+	lt--
+	gt++
+
+	arr[low], arr[lt] = arr[lt], arr[low]
+	p.Swap(low, lt)
+	arr[high], arr[gt] = arr[gt], arr[high]
+	p.Swap(high, gt)
+
+	return lt, gt
+}
@@ -0,0 +1,441 @@
+package sorting
+
+import "github.com/RaviParvadiya/learn_dsa/instrument"
+
+// RadixSort sorts non-negative integers one decimal digit at a time using
+// reusable buckets.
+func RadixSort(arr []int) {
+	radixSort(arr, nil)
+}
+
+func radixSort(arr []int, p *instrument.Probe) {
+	mustNonNegative("RadixSort", arr)
+
+	// Find max element
+	maxValue := 0
+	for i, v := range arr {
+		p.Compare(-1, i)
+		if maxValue < v {
+			maxValue = v
+		}
+	}
+
+	// Preallocate buckets
+	buckets := make([][]int, 10)
+	for i := range buckets {
+		buckets[i] = make([]int, 0, len(arr)/10+1)
+		p.Alloc(cap(buckets[i]))
+	}
+
+	div := 1
+	for maxValue/div > 0 {
+		radix(arr, buckets, div, p)
+		div *= 10
+	}
+}
+
+func radix(arr []int, buckets [][]int, div int, p *instrument.Probe) {
+	// Distribute elements into buckets based on current digit
+	for _, v := range arr {
+		radixIdx := (v / div) % 10
+		buckets[radixIdx] = append(buckets[radixIdx], v)
+	}
+
+	// Collect elements back into main array
+	writeIdx := 0
+	for i := range buckets {
+		copyInto(arr, writeIdx, buckets[i], p)
+		writeIdx += len(buckets[i])
+		buckets[i] = buckets[i][:0]
+	}
+}
+
+// RadixSortLSD is LSD (least significant digit) radix sort for non-negative
+// integers.
+func RadixSortLSD(arr []int) {
+	radixSortLSD(arr, nil)
+}
+
+func radixSortLSD(arr []int, p *instrument.Probe) {
+	mustNonNegative("RadixSortLSD", arr)
+
+	if len(arr) == 0 {
+		return
+	}
+
+	// Find maximum to know number of digits
+	max := arr[0]
+	for i, v := range arr {
+		p.Compare(i, -1)
+		if v > max {
+			max = v
+		}
+	}
+
+	// Do counting sort for every digit
+	for exp := 1; max/exp > 0; exp *= 10 {
+		countingSortByDigit(arr, exp, p)
+	}
+}
+
+func countingSortByDigit(arr []int, exp int, p *instrument.Probe) {
+	n := len(arr)
+	output := make([]int, n)
+	count := make([]int, 10)
+	p.Alloc(n)
+	p.Alloc(10)
+
+	// Count occurrences
+	for i := 0; i < n; i++ {
+		digit := (arr[i] / exp) % 10
+		count[digit]++
+	}
+
+	// Cumulative count
+	for i := 1; i < 10; i++ {
+		count[i] += count[i-1]
+	}
+
+	// Build output (traverse from right for stability)
+	for i := n - 1; i >= 0; i-- {
+		digit := (arr[i] / exp) % 10
+		output[count[digit]-1] = arr[i]
+		count[digit]--
+	}
+
+	copyInto(arr, 0, output, p)
+}
+
+// RadixSortMSD is MSD (most significant digit) radix sort for non-negative
+// integers.
+func RadixSortMSD(arr []int) {
+	radixSortMSD(arr, nil)
+}
+
+func radixSortMSD(arr []int, p *instrument.Probe) {
+	mustNonNegative("RadixSortMSD", arr)
+
+	if len(arr) == 0 {
+		return
+	}
+
+	max := arr[0]
+	for i, v := range arr {
+		p.Compare(i, -1)
+		if v > max {
+			max = v
+		}
+	}
+
+	// Find the most significant digit position
+	exp := 1
+	for max/exp >= 10 {
+		exp *= 10
+	}
+
+	radixSortMSDHelper(arr, exp, p)
+}
+
+func radixSortMSDHelper(arr []int, exp int, p *instrument.Probe) {
+	p.Enter()
+	defer p.Leave()
+
+	if len(arr) <= 1 || exp < 1 {
+		return
+	}
+
+	// Count sort by current digit
+	buckets := make([][]int, 10)
+	for i := range buckets {
+		buckets[i] = make([]int, 0)
+	}
+
+	for _, v := range arr {
+		digit := (v / exp) % 10
+		buckets[digit] = append(buckets[digit], v)
+	}
+	for i := range buckets {
+		p.Alloc(len(buckets[i]))
+	}
+
+	// Recursively sort each bucket
+	for i := range buckets {
+		if len(buckets[i]) > 1 {
+			radixSortMSDHelper(buckets[i], exp/10, p)
+		}
+	}
+
+	// Concatenate buckets
+	idx := 0
+	for i := range buckets {
+		for j := range buckets[i] {
+			arr[idx] = buckets[i][j]
+			p.Write(idx)
+			idx++
+		}
+	}
+}
+
+// RadixSortBinary is radix sort by bits instead of digits, for non-negative
+// integers.
+func RadixSortBinary(arr []int) {
+	radixSortBinary(arr, nil)
+}
+
+func radixSortBinary(arr []int, p *instrument.Probe) {
+	mustNonNegative("RadixSortBinary", arr)
+
+	if len(arr) == 0 {
+		return
+	}
+
+	max := arr[0]
+	for i, v := range arr {
+		p.Compare(i, -1)
+		if v > max {
+			max = v
+		}
+	}
+
+	// Process each bit
+	for bit := 0; (1 << bit) <= max; bit++ {
+		countingSortByBit(arr, bit, p)
+	}
+}
+
+func countingSortByBit(arr []int, bit int, p *instrument.Probe) {
+	n := len(arr)
+	output := make([]int, n)
+	count := make([]int, 2)
+	p.Alloc(n)
+	p.Alloc(2)
+
+	// Count 0s and 1s
+	for i := 0; i < n; i++ {
+		bitValue := (arr[i] >> bit) & 1
+		count[bitValue]++
+	}
+
+	// Cumulative count
+	count[1] += count[0]
+
+	// Build output
+	for i := n - 1; i >= 0; i-- {
+		bitValue := (arr[i] >> bit) & 1
+		output[count[bitValue]-1] = arr[i]
+		count[bitValue]--
+	}
+
+	copyInto(arr, 0, output, p)
+}
+
+// RadixSortWithNegatives is LSD radix sort that handles negative numbers by
+// sorting their magnitudes separately. Negating math.MinInt overflows, so
+// it cannot sort the full int range; RadixSortIntegers can.
+func RadixSortWithNegatives(arr []int) {
+	radixSortWithNegatives(arr, nil)
+}
+
+func radixSortWithNegatives(arr []int, p *instrument.Probe) {
+	if len(arr) == 0 {
+		return
+	}
+
+	// Separate positive and negative numbers
+	positive := []int{}
+	negative := []int{}
+
+	for i, v := range arr {
+		p.Compare(i, -1)
+		if v >= 0 {
+			positive = append(positive, v)
+		} else {
+			negative = append(negative, -v) // Make positive
+		}
+	}
+
+	// Sort both arrays
+	p.Alloc(len(positive))
+	p.Alloc(len(negative))
+	sub := p.Scratch()
+	radixSortLSD(positive, sub)
+	radixSortLSD(negative, sub)
+	p.Fold(sub)
+
+	// Combine: negatives (reversed) + positives
+	idx := 0
+	for i := len(negative) - 1; i >= 0; i-- {
+		arr[idx] = -negative[i]
+		p.Write(idx)
+		idx++
+	}
+	for i := 0; i < len(positive); i++ {
+		arr[idx] = positive[i]
+		p.Write(idx)
+		idx++
+	}
+}
+
+// RadixSortBase16 is LSD radix sort in base 16 (hexadecimal digits) for
+// non-negative integers.
+func RadixSortBase16(arr []int) {
+	radixSortBase16(arr, nil)
+}
+
+func radixSortBase16(arr []int, p *instrument.Probe) {
+	mustNonNegative("RadixSortBase16", arr)
+
+	if len(arr) == 0 {
+		return
+	}
+
+	max := arr[0]
+	for i, v := range arr {
+		p.Compare(i, -1)
+		if v > max {
+			max = v
+		}
+	}
+
+	// Process in base 16 (hexadecimal)
+	for exp := 1; max/exp > 0; exp *= 16 {
+		countingSortByDigitBase16(arr, exp, p)
+	}
+}
+
+func countingSortByDigitBase16(arr []int, exp int, p *instrument.Probe) {
+	n := len(arr)
+	output := make([]int, n)
+	count := make([]int, 16) // 0-F in hex
+	p.Alloc(n)
+	p.Alloc(16)
+
+	for i := 0; i < n; i++ {
+		digit := (arr[i] / exp) % 16
+		count[digit]++
+	}
+
+	for i := 1; i < 16; i++ {
+		count[i] += count[i-1]
+	}
+
+	for i := n - 1; i >= 0; i-- {
+		digit := (arr[i] / exp) % 16
+		output[count[digit]-1] = arr[i]
+		count[digit]--
+	}
+
+	copyInto(arr, 0, output, p)
+}
+
+// RadixSortMSDInPlace is space-efficient MSD radix sort of arr[low..high]
+// for non-negative integers, starting at decimal place exp (the largest
+// power of ten not above the maximum).
+func RadixSortMSDInPlace(arr []int, low, high, exp int) {
+	if low < high {
+		mustNonNegative("RadixSortMSDInPlace", arr[low:high+1])
+	}
+	radixSortMSDInPlace(arr, low, high, exp, nil)
+}
+
+func radixSortMSDInPlace(arr []int, low, high, exp int, p *instrument.Probe) {
+	p.Enter()
+	defer p.Leave()
+
+	if low >= high || exp < 1 {
+		return
+	}
+
+	// Count array for digits 0-9
+	count := make([]int, 11) // 10 digits + 1 for offset
+
+	// Count occurrences
+	for i := low; i <= high; i++ {
+		digit := (arr[i] / exp) % 10
+		count[digit+1]++
+	}
+
+	// Convert to starting indices
+	for i := 1; i < 11; i++ {
+		count[i] += count[i-1]
+	}
+
+	// Remember where each bucket starts; count is advanced while placing
+	start := make([]int, 11)
+	copy(start, count)
+
+	// Rearrange elements
+	temp := make([]int, high-low+1)
+	p.Alloc(len(temp))
+	for i := low; i <= high; i++ {
+		digit := (arr[i] / exp) % 10
+		temp[count[digit]] = arr[i]
+		count[digit]++
+	}
+
+	// Copy back
+	copyInto(arr, low, temp, p)
+
+	// Recursively sort each bucket
+	p.Split(low, high)
+	for i := 0; i < 10; i++ {
+		bucketStart := low + start[i]
+		bucketEnd := low + start[i+1] - 1
+		if bucketStart < bucketEnd {
+			radixSortMSDInPlace(arr, bucketStart, bucketEnd, exp/10, p)
+		}
+	}
+}
+
+// RadixSortStrings is LSD radix sort for strings, padding shorter strings
+// with zero bytes.
+func RadixSortStrings(arr []string) {
+	if len(arr) == 0 {
+		return
+	}
+
+	// Find max length
+	maxLen := len(arr[0])
+	for _, s := range arr {
+		if len(s) > maxLen {
+			maxLen = len(s)
+		}
+	}
+
+	// Sort from rightmost character to leftmost
+	for pos := maxLen - 1; pos >= 0; pos-- {
+		countingSortByChar(arr, pos, maxLen)
+	}
+}
+
+func countingSortByChar(arr []string, pos, maxLen int) {
+	n := len(arr)
+	output := make([]string, n)
+	count := make([]int, 256) // ASCII characters
+
+	// Count occurrences
+	for i := 0; i < n; i++ {
+		charIdx := 0
+		if pos < len(arr[i]) {
+			charIdx = int(arr[i][pos])
+		}
+		count[charIdx]++
+	}
+
+	// Cumulative count
+	for i := 1; i < 256; i++ {
+		count[i] += count[i-1]
+	}
+
+	// Build output
+	for i := n - 1; i >= 0; i-- {
+		charIdx := 0
+		if pos < len(arr[i]) {
+			charIdx = int(arr[i][pos])
+		}
+		output[count[charIdx]-1] = arr[i]
+		count[charIdx]--
+	}
+
+	copy(arr, output)
+}
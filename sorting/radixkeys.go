@@ -0,0 +1,187 @@
+package sorting
+
+import (
+	"math"
+	"math/bits"
+	"unsafe"
+
+	"github.com/RaviParvadiya/learn_dsa/instrument"
+)
+
+const (
+	// radixDigitBits is the width of the digits of the byte-wise radix
+	// sorts.
+	radixDigitBits = 8
+	radixBuckets   = 1 << radixDigitBits
+
+	// parallelRadixChunk is the fewest keys a worker of the parallel radix
+	// sort is given.
+	parallelRadixChunk = 1 << 16
+)
+
+// Integer is the set of integer types RadixSortIntegers sorts.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// RadixSortIntegers is LSD radix sort by bytes for any integer type,
+// negative values and math.MinInt64 included. Each key is sorted as its
+// bit pattern with the sign bit flipped, which orders negative values
+// before non-negative ones and each in numeric order, so unlike
+// RadixSortWithNegatives nothing is negated or split by sign. Passes over
+// a byte all keys share are skipped, and keys move back and forth between
+// arr and one buffer of the same length.
+func RadixSortIntegers[K Integer](arr []K) {
+	radixSortIntegers(arr, nil)
+}
+
+func radixSortIntegers[K Integer](arr []K, p *instrument.Probe) {
+	width := int(unsafe.Sizeof(K(0))) * 8
+	var sign uint64
+	if ^K(0) < 0 {
+		sign = 1 << (width - 1)
+	}
+	radixSortKeys(arr, width, func(k K) uint64 { return uint64(k) ^ sign }, 1, p)
+}
+
+// RadixSortFloats is LSD radix sort by bytes for float32 and float64 keys
+// of any range. Each key is mapped to an unsigned integer in the same
+// order: the bits of a non-negative float with the sign bit set, or all
+// the bits of a negative one inverted, so -Inf comes first and +Inf last.
+//
+// The order is that of cmp.Compare, and the sort is stable under it: NaNs,
+// whatever their sign and payload, come before every other value in their
+// input order, and -0 and +0 are equal, so they too keep their input order.
+func RadixSortFloats[F ~float32 | ~float64](arr []F) {
+	radixSortFloats(arr, nil)
+}
+
+func radixSortFloats[F ~float32 | ~float64](arr []F, p *instrument.Probe) {
+	if unsafe.Sizeof(F(0)) == 4 {
+		radixSortKeys(arr, 32, func(f F) uint64 { return uint64(floatKey32(float32(f))) }, 1, p)
+	} else {
+		radixSortKeys(arr, 64, func(f F) uint64 { return floatKey64(float64(f)) }, 1, p)
+	}
+}
+
+// floatKey64 maps f to a key that orders as cmp.Compare orders floats.
+// NaNs map to 0, below every other key.
+func floatKey64(f float64) uint64 {
+	if f != f {
+		return 0
+	}
+	if f == 0 {
+		f = 0 // -0 sorts as +0
+	}
+	b := math.Float64bits(f)
+	if b&(1<<63) != 0 {
+		return ^b
+	}
+	return b | 1<<63
+}
+
+// floatKey32 is floatKey64 for float32.
+func floatKey32(f float32) uint32 {
+	if f != f {
+		return 0
+	}
+	if f == 0 {
+		f = 0
+	}
+	b := math.Float32bits(f)
+	if b&(1<<31) != 0 {
+		return ^b
+	}
+	return b | 1<<31
+}
+
+// RadixSortParallel is LSD radix sort of unsigned keys by 8-bit digits, run
+// on a fixed pool of goroutines, workers of them or GOMAXPROCS if
+// workers <= 0. Each goroutine owns a contiguous chunk of the keys.
+//
+// On every pass each goroutine counts the digits of its chunk into its own
+// histogram. A prefix sum over all the histograms, digit by digit and then
+// chunk by chunk, gives every goroutine the positions its keys go to, and
+// they all scatter at once. Keys move back and forth between arr and a
+// single buffer, and passes over a digit all keys share are skipped.
+func RadixSortParallel[K ~uint32 | ~uint64](arr []K, workers int) {
+	radixSortKeys(arr, bits.Len64(uint64(^K(0))), func(k K) uint64 { return uint64(k) }, workers, nil)
+}
+
+// radixSortKeys sorts arr stably by the low keyBits bits of key, a byte at
+// a time from the least significant, on a pool of workers goroutines (see
+// RadixSortParallel). p records the buffer and the stores into arr, and
+// must be nil unless workers is 1.
+func radixSortKeys[K any](arr []K, keyBits int, key func(K) uint64, workers int, p *instrument.Probe) {
+	n := len(arr)
+	if n < 2 {
+		return
+	}
+	pl := newPool(workers)
+	defer pl.close()
+
+	chunks := max(1, min(pl.size, n/parallelRadixChunk))
+	size := (n + chunks - 1) / chunks
+	hist := make([][radixBuckets]int, chunks)
+
+	src, dst := arr, make([]K, n)
+	p.Alloc(n)
+	for shift := 0; shift < keyBits; shift += radixDigitBits {
+		digit := func(k K) int {
+			return int(key(k) >> shift & (radixBuckets - 1))
+		}
+
+		g := pl.group()
+		for c := range hist {
+			g.fork(func() {
+				h := &hist[c]
+				*h = [radixBuckets]int{}
+				for _, k := range src[c*size : min((c+1)*size, n)] {
+					h[digit(k)]++
+				}
+			})
+		}
+		g.wait()
+
+		// Turn the counts into starting positions, skipping the pass if
+		// every key has the same digit.
+		pos := 0
+		skip := false
+		for d := range radixBuckets {
+			start := pos
+			for c := range hist {
+				pos, hist[c][d] = pos+hist[c][d], pos
+			}
+			if pos-start == n {
+				skip = true
+				break
+			}
+		}
+		if skip {
+			continue
+		}
+
+		toArr := p != nil && &dst[0] == &arr[0]
+		g = pl.group()
+		for c := range hist {
+			g.fork(func() {
+				h := &hist[c]
+				for _, k := range src[c*size : min((c+1)*size, n)] {
+					d := digit(k)
+					dst[h[d]] = k
+					if toArr {
+						p.Write(h[d])
+					}
+					h[d]++
+				}
+			})
+		}
+		g.wait()
+		src, dst = dst, src
+	}
+
+	if &src[0] != &arr[0] {
+		copyInto(arr, 0, src, p)
+	}
+}
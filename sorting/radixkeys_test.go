@@ -0,0 +1,140 @@
+package sorting_test
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+	"unsafe"
+
+	"github.com/RaviParvadiya/learn_dsa/sorting"
+)
+
+// checkRadixIntegers sorts random bit patterns of K, truncated from 64
+// bits, mixed with its extremes and small values around zero, and compares
+// RadixSortIntegers with slices.Sort.
+func checkRadixIntegers[K sorting.Integer](t *testing.T) {
+	t.Helper()
+	width := unsafe.Sizeof(K(0)) * 8
+	top := K(1) << (width - 1) // the minimum if K is signed
+	edges := []K{0, 1, ^K(0), top, ^top, top + 1, ^top - 1}
+
+	rng := rand.New(rand.NewSource(6))
+	for _, n := range []int{0, 1, 2, 10, 1000} {
+		input := make([]K, n)
+		for i := range input {
+			switch rng.Intn(3) {
+			case 0:
+				input[i] = K(rng.Uint64())
+			case 1:
+				input[i] = edges[rng.Intn(len(edges))]
+			default:
+				input[i] = K(rng.Intn(7)) - 3
+			}
+		}
+		got := slices.Clone(input)
+		want := slices.Clone(input)
+		sorting.RadixSortIntegers(got)
+		slices.Sort(want)
+		if !slices.Equal(got, want) {
+			t.Errorf("%T, %d keys: got %v, want %v", K(0), n, head(got), head(want))
+		}
+	}
+}
+
+// head returns at most the first 10 elements of arr, for error messages.
+func head[T any](arr []T) []T {
+	return arr[:min(len(arr), 10)]
+}
+
+type celsius int16
+
+func TestRadixSortIntegers(t *testing.T) {
+	checkRadixIntegers[int](t)
+	checkRadixIntegers[int8](t)
+	checkRadixIntegers[int16](t)
+	checkRadixIntegers[int32](t)
+	checkRadixIntegers[int64](t)
+	checkRadixIntegers[uint](t)
+	checkRadixIntegers[uint8](t)
+	checkRadixIntegers[uint16](t)
+	checkRadixIntegers[uint32](t)
+	checkRadixIntegers[uint64](t)
+	checkRadixIntegers[uintptr](t)
+	checkRadixIntegers[celsius](t)
+
+	arr := []int64{0, math.MinInt64, -1, math.MaxInt64, math.MinInt64 + 1, 1}
+	sorting.RadixSortIntegers(arr)
+	want := []int64{math.MinInt64, math.MinInt64 + 1, -1, 0, 1, math.MaxInt64}
+	if !slices.Equal(arr, want) {
+		t.Errorf("got %v, want %v", arr, want)
+	}
+}
+
+// checkRadixFloats compares RadixSortFloats on input with a stable sort by
+// cmp.Compare, bit for bit, so that the order of NaNs with different
+// payloads and of -0 and +0 is checked too.
+func checkRadixFloats[F float32 | float64](t *testing.T, name string, input []F, bits func(F) uint64) {
+	t.Helper()
+	got := slices.Clone(input)
+	want := slices.Clone(input)
+	sorting.RadixSortFloats(got)
+	slices.SortStableFunc(want, cmp.Compare[F])
+	for i := range got {
+		if bits(got[i]) != bits(want[i]) {
+			t.Errorf("%s: got %v, want %v", name, head(got[i:]), head(want[i:]))
+			return
+		}
+	}
+}
+
+func TestRadixSortFloats(t *testing.T) {
+	nan1 := math.Float64frombits(0x7ff8000000000001)
+	nan2 := math.Float64frombits(0xfff8000000000002) // sign bit set
+	nan3 := math.NaN()
+	negZero := math.Copysign(0, -1)
+	specials := []float64{
+		nan1, 1, negZero, math.Inf(1), nan2, 0, -1, math.Inf(-1), negZero, nan3,
+		math.MaxFloat64, -math.MaxFloat64, math.SmallestNonzeroFloat64, -math.SmallestNonzeroFloat64, 0,
+	}
+	checkRadixFloats(t, "float64 specials", specials, math.Float64bits)
+
+	specials32 := make([]float32, len(specials))
+	for i, f := range specials {
+		specials32[i] = float32(f)
+	}
+	specials32 = append(specials32, math.MaxFloat32, -math.MaxFloat32, math.SmallestNonzeroFloat32,
+		float32(math.Float64frombits(0x7ff8000000000003)))
+	bits32 := func(f float32) uint64 { return uint64(math.Float32bits(f)) }
+	checkRadixFloats(t, "float32 specials", specials32, bits32)
+
+	// NaNs first, in input order, then -Inf, the zeros in input order,
+	// and +Inf.
+	got := []float64{math.Inf(1), 0, nan2, negZero, math.Inf(-1), nan1}
+	sorting.RadixSortFloats(got)
+	want := []float64{nan2, nan1, math.Inf(-1), 0, negZero, math.Inf(1)}
+	for i := range got {
+		if math.Float64bits(got[i]) != math.Float64bits(want[i]) {
+			t.Errorf("got %v, want %v", got, want)
+			break
+		}
+	}
+
+	rng := rand.New(rand.NewSource(7))
+	for _, n := range []int{0, 1, 2, 10, 1000} {
+		wide := make([]float64, n)
+		narrow := make([]float32, n)
+		for i := range wide {
+			if rng.Intn(4) == 0 {
+				wide[i] = specials[rng.Intn(len(specials))]
+			} else {
+				wide[i] = math.Float64frombits(rng.Uint64())
+			}
+			narrow[i] = math.Float32frombits(rng.Uint32())
+		}
+		checkRadixFloats(t, fmt.Sprintf("float64/%d", n), wide, math.Float64bits)
+		checkRadixFloats(t, fmt.Sprintf("float32/%d", n), narrow, bits32)
+	}
+}
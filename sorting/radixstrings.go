@@ -0,0 +1,167 @@
+package sorting
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// americanFlagInsertion is the bucket size below which the MSD string sorts
+// finish with insertion sort.
+const americanFlagInsertion = 16
+
+// RadixSortStringsMSD is MSD radix sort of strings by bytes, using American
+// flag sort to distribute each bucket in place: one pass counts the
+// strings per next byte, a second swaps every string into its bucket.
+// Strings that end at the current byte form a bucket of their own, ahead
+// of the rest, so no padding is needed and an outlier long string costs
+// nothing beyond its own length. Only buckets of two or more strings are
+// sorted further, and small ones are finished with insertion sort.
+//
+// The result is the order of the < operator on strings. For valid UTF-8
+// that is also the order of their code points. It is not stable, which
+// for strings is only visible through identity.
+func RadixSortStringsMSD(arr []string) {
+	americanFlag(arr, 0, func(s string) string { return s }, nil)
+}
+
+// RadixSortStringsMSDRunes is RadixSortStringsMSD ordering strings by their
+// sequences of code points, decoded as a for range loop decodes them: each
+// byte that is not part of valid UTF-8 reads as utf8.RuneError (U+FFFD).
+// On valid UTF-8 it gives the same order as RadixSortStringsMSD; otherwise
+// the strings holding invalid bytes are sorted by a re-encoded copy.
+func RadixSortStringsMSDRunes(arr []string) {
+	valid := true
+	for _, s := range arr {
+		if !utf8.ValidString(s) {
+			valid = false
+			break
+		}
+	}
+	if valid {
+		RadixSortStringsMSD(arr)
+		return
+	}
+
+	type keyed struct{ key, s string }
+	ks := make([]keyed, len(arr))
+	for i, s := range arr {
+		ks[i] = keyed{runeKey(s), s}
+	}
+	americanFlag(ks, 0, func(k keyed) string { return k.key }, nil)
+	for i, k := range ks {
+		arr[i] = k.s
+	}
+}
+
+// RadixSortByKey stably sorts arr by the byte order of key(v), such as a
+// collation key of package collate or a composite key of package keyenc,
+// with the MSD radix sort of RadixSortStringsMSD. Each key is computed
+// once, and the elements are then moved once, so it suits large records
+// with costly keys. It allocates the keys and a copy of arr.
+func RadixSortByKey[T any](arr []T, key func(T) string) {
+	type keyed struct {
+		key string
+		i   int
+	}
+	ks := make([]keyed, len(arr))
+	for i, v := range arr {
+		ks[i] = keyed{key(v), i}
+	}
+	americanFlag(ks, 0, func(k keyed) string { return k.key }, func(a, b keyed) bool { return a.i < b.i })
+
+	sorted := make([]T, len(arr))
+	for j, k := range ks {
+		sorted[j] = arr[k.i]
+	}
+	copy(arr, sorted)
+}
+
+// runeKey returns s with every invalid byte replaced by the UTF-8 encoding
+// of utf8.RuneError, or s itself if it is valid UTF-8.
+func runeKey(s string) string {
+	if utf8.ValidString(s) {
+		return s
+	}
+	var b strings.Builder
+	b.Grow(len(s) + len(s)/2)
+	for _, r := range s {
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// americanFlag sorts arr by key, given that the keys all share their first
+// d bytes. Elements with equal keys are ordered by tie, if not nil.
+func americanFlag[E any](arr []E, d int, key func(E) string, tie func(a, b E) bool) {
+	// digit is the bucket of e: 0 if its key ends before byte d, or one
+	// more than the byte.
+	digit := func(e E, d int) int {
+		if k := key(e); d < len(k) {
+			return int(k[d]) + 1
+		}
+		return 0
+	}
+
+	for len(arr) > 1 {
+		if len(arr) < americanFlagInsertion {
+			insertionSort(arr, 0, len(arr)-1, func(a, b E) bool {
+				if ka, kb := key(a)[d:], key(b)[d:]; ka != kb {
+					return ka < kb
+				}
+				return tie != nil && tie(a, b)
+			}, nil)
+			return
+		}
+
+		var count [257]int
+		for _, e := range arr {
+			count[digit(e, d)]++
+		}
+
+		// All the keys share byte d as well: move on to the next one
+		// without recursing.
+		if count[digit(arr[0], d)] == len(arr) {
+			if digit(arr[0], d) == 0 {
+				breakTies(arr, tie)
+				return
+			}
+			d++
+			continue
+		}
+
+		// next[b] is where the next element of bucket b goes, and end[b]
+		// where bucket b ends.
+		var next, end [257]int
+		pos := 0
+		for b, c := range count {
+			next[b] = pos
+			pos += c
+			end[b] = pos
+		}
+		for b := range next {
+			for next[b] < end[b] {
+				c := digit(arr[next[b]], d)
+				if c != b {
+					arr[next[b]], arr[next[c]] = arr[next[c]], arr[next[b]]
+				}
+				next[c]++
+			}
+		}
+
+		// Bucket 0 holds keys equal up to their end, so only ties are left.
+		breakTies(arr[:count[0]], tie)
+		for b := 1; b < len(count); b++ {
+			if count[b] > 1 {
+				americanFlag(arr[end[b]-count[b]:end[b]], d+1, key, tie)
+			}
+		}
+		return
+	}
+}
+
+// breakTies sorts arr, whose keys are all equal, by tie if not nil.
+func breakTies[E any](arr []E, tie func(a, b E) bool) {
+	if tie != nil && len(arr) > 1 {
+		pdqsort(arr, tie, nil)
+	}
+}
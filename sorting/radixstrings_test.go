@@ -0,0 +1,87 @@
+package sorting_test
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/RaviParvadiya/learn_dsa/sorting"
+)
+
+// stringInputs returns inputs for the MSD string sorts: empty strings,
+// NULs, invalid UTF-8, buckets on either side of the 16 strings below
+// which they insertion sort, and long shared prefixes.
+func stringInputs() map[string][]string {
+	in := map[string][]string{
+		"nil":     nil,
+		"empty":   {"", "", ""},
+		"one":     {"x"},
+		"nul":     {"a\x00", "a", "\x00", "", "a\x00\x00", "\x00a", "a\x01", "\x00\x00"},
+		"invalid": {"\xff", "a\xffb", "\xfe", "�", "a", "\xc3", "\xc3\xa9", "é", "z", "a�b", "", "\U0001F600"},
+	}
+
+	// Random strings over a small alphabet, including a NUL, an invalid
+	// byte and a multibyte rune, give buckets of every size.
+	alphabet := []string{"", "\x00", "a", "b", "c", "\xff", "é", "\U0001F600"}
+	rng := rand.New(rand.NewSource(8))
+	for _, n := range []int{15, 16, 17, 100, 2000} {
+		arr := make([]string, n)
+		for i := range arr {
+			var b strings.Builder
+			for range rng.Intn(6) {
+				b.WriteString(alphabet[rng.Intn(len(alphabet))])
+			}
+			arr[i] = b.String()
+		}
+		in[fmt.Sprintf("random/%d", n)] = arr
+	}
+
+	// Strings sharing a prefix far longer than any bucket, differing at
+	// their end or in their length.
+	prefix := strings.Repeat("prefix/", 200)
+	var shared []string
+	for i := range 300 {
+		shared = append(shared, prefix+fmt.Sprint(rng.Intn(50)), prefix[:len(prefix)-i%7])
+	}
+	in["shared prefix"] = shared
+	return in
+}
+
+func TestRadixSortStringsMSD(t *testing.T) {
+	for name, input := range stringInputs() {
+		got := slices.Clone(input)
+		want := slices.Clone(input)
+		sorting.RadixSortStringsMSD(got)
+		slices.Sort(want)
+		if !slices.Equal(got, want) {
+			t.Errorf("%s: got %q, want %q", name, head(got), head(want))
+		}
+	}
+}
+
+// TestRadixSortStringsMSDRunes compares the code points of the result with
+// those of a sort by code points. Strings differing only in which invalid
+// bytes they hold decode to the same code points, and the sort is not
+// stable, so their order is not checked, but the strings themselves are.
+func TestRadixSortStringsMSDRunes(t *testing.T) {
+	byRunes := func(a, b string) int { return slices.Compare([]rune(a), []rune(b)) }
+	for name, input := range stringInputs() {
+		got := slices.Clone(input)
+		want := slices.Clone(input)
+		sorting.RadixSortStringsMSDRunes(got)
+		slices.SortFunc(want, byRunes)
+		for i := range got {
+			if byRunes(got[i], want[i]) != 0 {
+				t.Errorf("%s: got %q, want %q", name, head(got[i:]), head(want[i:]))
+				break
+			}
+		}
+		slices.Sort(got)
+		slices.Sort(want)
+		if !slices.Equal(got, want) {
+			t.Errorf("%s: output is not a permutation of the input", name)
+		}
+	}
+}
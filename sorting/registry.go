@@ -0,0 +1,219 @@
+package sorting
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+
+	"github.com/RaviParvadiya/learn_dsa/instrument"
+)
+
+// Algorithm is one sorting variant adapted to sort a whole []int in
+// ascending order, reporting its operations to p (which may be nil), along
+// with the properties the conformance suite holds it to.
+type Algorithm struct {
+	Name   string // name of the exported function, e.g. "QuicksortLomuto"
+	Family string // group of related variants, e.g. "quick" or "radix"
+
+	Stable    bool // equal elements keep their relative order under SortFunc
+	InPlace   bool // allocates fewer elements than it sorts
+	Negatives bool // sorts negative values
+	MaxRange  int  // largest max-min of an input it can sort in reasonable memory, or 0 for no limit
+
+	Sort func(arr []int, p *instrument.Probe)
+
+	// SortFunc sorts by less instead of <. It is set only for the
+	// comparison sorts, for which Sort is SortFunc with cmp.Less. The other
+	// sorts order bare ints, among which equal elements cannot be told
+	// apart, so they are not declared Stable even where the algorithm is.
+	SortFunc func(arr []int, less func(a, b int) bool, p *instrument.Probe)
+}
+
+// Algorithms returns every registered variant, grouped by family. Variants
+// taking bounds are run over the whole slice, and variants returning a new
+// slice have the result copied back into arr. The descending, Custom and
+// Objects variants are left out as they duplicate the algorithms listed.
+func Algorithms() []Algorithm {
+	return slices.Clone(algorithms)
+}
+
+// Lookup returns the registered variant with the given name, ignoring case.
+func Lookup(name string) (Algorithm, bool) {
+	for _, a := range algorithms {
+		if strings.EqualFold(a.Name, name) {
+			return a, true
+		}
+	}
+	return Algorithm{}, false
+}
+
+// Register adds a to the registry, filling in Sort from SortFunc if only
+// the latter is set, so that it is listed, benchmarked and held to the
+// conformance suite like the built-in variants. It panics if a has no name,
+// no sort function, or the name of a variant already registered. Register
+// is meant to be called from init functions.
+func Register(a Algorithm) {
+	if a.Name == "" || (a.Sort == nil && a.SortFunc == nil) {
+		panic("sorting: Register needs a name and a sort function")
+	}
+	if _, ok := Lookup(a.Name); ok {
+		panic("sorting: Register called twice for " + a.Name)
+	}
+	algorithms = append(algorithms, withSort([]Algorithm{a})...)
+}
+
+// countingRange bounds the inputs given to the counting sorts, whose count
+// arrays span the whole range of values.
+const countingRange = 1 << 20
+
+var algorithms = withSort([]Algorithm{
+	{Name: "BubbleSortBasic", Family: "bubble", Stable: true, InPlace: true, Negatives: true, SortFunc: bubbleSortBasic[int]},
+	{Name: "BubbleSortOptimized", Family: "bubble", Stable: true, InPlace: true, Negatives: true, SortFunc: bubbleSortOptimized[int]},
+	{Name: "BubbleSortRecursive", Family: "bubble", Stable: true, InPlace: true, Negatives: true, SortFunc: func(a []int, less func(a, b int) bool, p *instrument.Probe) {
+		bubbleSortRecursive(a, len(a), less, p)
+	}},
+	{Name: "BubbleSortCocktail", Family: "bubble", Stable: true, InPlace: true, Negatives: true, SortFunc: bubbleSortCocktail[int]},
+	{Name: "BubbleSortOddEven", Family: "bubble", Stable: true, InPlace: true, Negatives: true, SortFunc: bubbleSortOddEven[int]},
+	{Name: "BubbleSortComb", Family: "bubble", InPlace: true, Negatives: true, SortFunc: bubbleSortComb[int]},
+	{Name: "BubbleSortLastSwap", Family: "bubble", Stable: true, InPlace: true, Negatives: true, SortFunc: bubbleSortLastSwap[int]},
+
+	{Name: "InsertionSortBasic", Family: "insertion", Stable: true, InPlace: true, Negatives: true, SortFunc: insertionSortBasic[int]},
+	{Name: "InsertionSortSwapping", Family: "insertion", Stable: true, InPlace: true, Negatives: true, SortFunc: insertionSortSwapping[int]},
+	{Name: "InsertionSortBinary", Family: "insertion", Stable: true, InPlace: true, Negatives: true, SortFunc: insertionSortBinary[int]},
+	{Name: "InsertionSortRecursive", Family: "insertion", Stable: true, InPlace: true, Negatives: true, SortFunc: func(a []int, less func(a, b int) bool, p *instrument.Probe) {
+		insertionSortRecursive(a, len(a), less, p)
+	}},
+	{Name: "InsertionSortSentinel", Family: "insertion", InPlace: true, Negatives: true, SortFunc: insertionSortSentinel[int]},
+	{Name: "InsertionSortWithGap", Family: "insertion", InPlace: true, Negatives: true, SortFunc: shellSort},
+	{Name: "InsertionSortBidirectional", Family: "insertion", Stable: true, InPlace: true, Negatives: true, SortFunc: insertionSortBidirectional[int]},
+	{Name: "InsertionSortEarlyTermination", Family: "insertion", Stable: true, InPlace: true, Negatives: true, SortFunc: insertionSortEarlyTermination[int]},
+
+	{Name: "SelectionSortBasic", Family: "selection", InPlace: true, Negatives: true, SortFunc: selectionSortBasic[int]},
+	{Name: "SelectionSortBidirectional", Family: "selection", InPlace: true, Negatives: true, SortFunc: selectionSortBidirectional[int]},
+	{Name: "SelectionSortRecursive", Family: "selection", InPlace: true, Negatives: true, SortFunc: func(a []int, less func(a, b int) bool, p *instrument.Probe) {
+		selectionSortRecursive(a, 0, less, p)
+	}},
+	{Name: "SelectionSortStable", Family: "selection", Stable: true, InPlace: true, Negatives: true, SortFunc: selectionSortStable[int]},
+	{Name: "SelectionSortEarlyTermination", Family: "selection", InPlace: true, Negatives: true, SortFunc: selectionSortEarlyTermination[int]},
+	{Name: "SelectionSortOptimized", Family: "selection", InPlace: true, Negatives: true, SortFunc: selectionSortOptimized[int]},
+
+	{Name: "QuickSort", Family: "quick", InPlace: true, Negatives: true, SortFunc: introsort[int]},
+	{Name: "QuicksortLomuto", Family: "quick", InPlace: true, Negatives: true, SortFunc: whole(quicksortLomuto[int])},
+	{Name: "QuicksortHoare", Family: "quick", InPlace: true, Negatives: true, SortFunc: whole(quicksortHoare[int])},
+	{Name: "QuicksortHoareLastPivot", Family: "quick", InPlace: true, Negatives: true, SortFunc: whole(quicksortHoareLastPivot[int])},
+	{Name: "QuicksortHoareMidPivot", Family: "quick", InPlace: true, Negatives: true, SortFunc: whole(quicksortHoareMidPivot[int])},
+	{Name: "QuicksortThreeWay", Family: "quick", InPlace: true, Negatives: true, SortFunc: whole(quicksortThreeWay[int])},
+	{Name: "QuicksortRandomized", Family: "quick", InPlace: true, Negatives: true, SortFunc: whole(quicksortRandomized[int])},
+	{Name: "QuicksortIterative", Family: "quick", Negatives: true, SortFunc: whole(quicksortIterative[int])},
+	{Name: "QuicksortHybrid", Family: "quick", InPlace: true, Negatives: true, SortFunc: whole(quicksortHybrid[int])},
+	{Name: "QuicksortDualPivot", Family: "quick", InPlace: true, Negatives: true, SortFunc: whole(quicksortDualPivot[int])},
+	{Name: "Pdqsort", Family: "quick", InPlace: true, Negatives: true, SortFunc: pdqsort[int]},
+	{Name: "QuickSortParallel", Family: "quick", InPlace: true, Negatives: true, SortFunc: func(a []int, less func(a, b int) bool, p *instrument.Probe) {
+		quickSortParallel(a, probeWorkers(p), less, p)
+	}},
+
+	{Name: "MergeSort1", Family: "merge", Stable: true, Negatives: true, SortFunc: whole(mergeSort1[int])},
+	{Name: "MergeSort2", Family: "merge", Stable: true, Negatives: true, SortFunc: whole(mergeSort2[int])},
+	{Name: "MergeSortBasic", Family: "merge", Stable: true, Negatives: true, SortFunc: returning(mergeSortBasic[int])},
+	{Name: "MergeSortInPlace", Family: "merge", Stable: true, InPlace: true, Negatives: true, SortFunc: whole(mergeSortInPlace[int])},
+	{Name: "MergeSortIterative", Family: "merge", Stable: true, Negatives: true, SortFunc: mergeSortIterative[int]},
+	{Name: "MergeSortThreeWay", Family: "merge", Stable: true, Negatives: true, SortFunc: returning(mergeSortThreeWay[int])},
+	{Name: "MergeSortHybrid", Family: "merge", Stable: true, Negatives: true, SortFunc: whole(mergeSortHybrid[int])},
+	{Name: "MergeSortNatural", Family: "merge", Stable: true, Negatives: true, SortFunc: mergeSortNatural[int]},
+	{Name: "BlockMergeSort", Family: "merge", Stable: true, InPlace: true, Negatives: true, SortFunc: blockMergeSort[int]},
+	{Name: "Timsort", Family: "merge", Stable: true, Negatives: true, SortFunc: timsort[int]},
+	{Name: "MergeSortParallel", Family: "merge", Stable: true, Negatives: true, SortFunc: func(a []int, less func(a, b int) bool, p *instrument.Probe) {
+		mergeSortParallel(a, probeWorkers(p), less, p)
+	}},
+
+	{Name: "CountingSortBasic", Family: "counting", MaxRange: countingRange, Sort: func(a []int, p *instrument.Probe) { copyInto(a, 0, countingSortBasic(a, p), p) }},
+	{Name: "CountingSortInPlace", Family: "counting", MaxRange: countingRange, Sort: func(a []int, p *instrument.Probe) { countingSortInPlace(a, p) }},
+	{Name: "CountingSortStable", Family: "counting", MaxRange: countingRange, Sort: func(a []int, p *instrument.Probe) { copyInto(a, 0, countingSortStable(a, p), p) }},
+	{Name: "CountingSortWithNegatives", Family: "counting", Negatives: true, MaxRange: countingRange, Sort: func(a []int, p *instrument.Probe) { copyInto(a, 0, countingSortWithNegatives(a, p), p) }},
+	{Name: "CountingSortLimitedRange", Family: "counting", Negatives: true, MaxRange: countingRange, Sort: countingSortWholeRange},
+	{Name: "CountingSortOptimized", Family: "counting", Negatives: true, MaxRange: countingRange, Sort: func(a []int, p *instrument.Probe) { copyInto(a, 0, countingSortOptimized(a, p), p) }},
+
+	{Name: "RadixSort", Family: "radix", Sort: func(a []int, p *instrument.Probe) { radixSort(a, p) }},
+	{Name: "RadixSortLSD", Family: "radix", Sort: func(a []int, p *instrument.Probe) { radixSortLSD(a, p) }},
+	{Name: "RadixSortMSD", Family: "radix", Sort: func(a []int, p *instrument.Probe) { radixSortMSD(a, p) }},
+	{Name: "RadixSortBinary", Family: "radix", Sort: func(a []int, p *instrument.Probe) { radixSortBinary(a, p) }},
+	{Name: "RadixSortWithNegatives", Family: "radix", Negatives: true, Sort: func(a []int, p *instrument.Probe) { radixSortWithNegatives(a, p) }},
+	{Name: "RadixSortBase16", Family: "radix", Sort: func(a []int, p *instrument.Probe) { radixSortBase16(a, p) }},
+	{Name: "RadixSortMSDInPlace", Family: "radix", Sort: radixSortMSDInPlaceWhole},
+	{Name: "RadixSortIntegers", Family: "radix", Negatives: true, Sort: radixSortIntegers[int]},
+	{Name: "RadixSortParallel", Family: "radix", Negatives: true, Sort: radixSortParallelInts},
+
+	{Name: "BucketSortIntegers", Family: "bucket", Negatives: true, Sort: func(a []int, p *instrument.Probe) { bucketSortIntegers(a, p) }},
+	{Name: "BucketSortFixedBuckets", Family: "bucket", Negatives: true, Sort: func(a []int, p *instrument.Probe) { bucketSortFixedBuckets(a, 10, p) }},
+})
+
+// withSort fills in Sort for the comparison sorts from their SortFunc.
+func withSort(algos []Algorithm) []Algorithm {
+	for i, a := range algos {
+		if a.Sort == nil && a.SortFunc != nil {
+			algos[i].Sort = func(arr []int, p *instrument.Probe) { a.SortFunc(arr, cmp.Less[int], p) }
+		}
+	}
+	return algos
+}
+
+// whole adapts a variant taking inclusive bounds to sort the whole slice.
+func whole(sort func(arr []int, low, high int, less func(a, b int) bool, p *instrument.Probe)) func([]int, func(a, b int) bool, *instrument.Probe) {
+	return func(arr []int, less func(a, b int) bool, p *instrument.Probe) {
+		sort(arr, 0, len(arr)-1, less, p)
+	}
+}
+
+// returning adapts a variant returning a new slice to sort in place.
+func returning(sort func(arr []int, less func(a, b int) bool, p *instrument.Probe) []int) func([]int, func(a, b int) bool, *instrument.Probe) {
+	return func(arr []int, less func(a, b int) bool, p *instrument.Probe) {
+		copyInto(arr, 0, sort(arr, less, p), p)
+	}
+}
+
+// shellSort runs InsertionSortWithGap with halving gaps down to 1.
+func shellSort(a []int, less func(a, b int) bool, p *instrument.Probe) {
+	for gap := len(a) / 2; gap > 1; gap /= 2 {
+		insertionSortWithGap(a, gap, less, p)
+	}
+	insertionSortWithGap(a, 1, less, p)
+}
+
+// probeWorkers is the number of workers a parallel sort may run on while
+// reporting to p: one if there is a Probe, which is not safe for
+// concurrent use, and otherwise GOMAXPROCS.
+func probeWorkers(p *instrument.Probe) int {
+	if p != nil {
+		return 1
+	}
+	return 0
+}
+
+// countingSortWholeRange runs CountingSortLimitedRange over [min(a), max(a)].
+func countingSortWholeRange(a []int, p *instrument.Probe) {
+	if len(a) == 0 {
+		return
+	}
+	copyInto(a, 0, countingSortLimitedRange(a, slices.Min(a), slices.Max(a), p), p)
+}
+
+// radixSortMSDInPlaceWhole runs RadixSortMSDInPlace over the whole slice,
+// starting at the most significant decimal place of its maximum.
+func radixSortMSDInPlaceWhole(a []int, p *instrument.Probe) {
+	mustNonNegative("RadixSortMSDInPlace", a)
+	if len(a) == 0 {
+		return
+	}
+	exp := 1
+	for m := slices.Max(a); m/exp >= 10; {
+		exp *= 10
+	}
+	radixSortMSDInPlace(a, 0, len(a)-1, exp, p)
+}
+
+// radixSortParallelInts runs RadixSortParallel on a, keyed by the values
+// as uint64 with the sign bit flipped, which orders them as the ints.
+func radixSortParallelInts(a []int, p *instrument.Probe) {
+	const sign = 1 << 63
+	radixSortKeys(a, 64, func(v int) uint64 { return uint64(v) ^ sign }, probeWorkers(p), p)
+}
@@ -0,0 +1,257 @@
+package sorting
+
+import (
+	"cmp"
+
+	"github.com/RaviParvadiya/learn_dsa/comparator"
+	"github.com/RaviParvadiya/learn_dsa/instrument"
+	"github.com/RaviParvadiya/learn_dsa/records"
+)
+
+// SelectionSortBasic is the basic selection sort (ascending order).
+func SelectionSortBasic[T cmp.Ordered](arr []T) {
+	selectionSortBasic(arr, cmp.Less[T], nil)
+}
+
+// SelectionSortBasicFunc is SelectionSortBasic ordered by less.
+func SelectionSortBasicFunc[T any](arr []T, less func(a, b T) bool) {
+	selectionSortBasic(arr, less, nil)
+}
+
+func selectionSortBasic[T any](arr []T, less func(a, b T) bool, p *instrument.Probe) {
+	n := len(arr)
+	for i := 0; i < n-1; i++ {
+		// Find minimum element in unsorted portion
+		minIdx := i
+		for j := i + 1; j < n; j++ {
+			p.Compare(j, minIdx)
+			if less(arr[j], arr[minIdx]) {
+				minIdx = j
+			}
+		}
+		// Swap minimum with first unsorted element
+		arr[i], arr[minIdx] = arr[minIdx], arr[i]
+		p.Swap(i, minIdx)
+	}
+}
+
+// SelectionSortDescending is selection sort in descending order.
+func SelectionSortDescending[T cmp.Ordered](arr []T) {
+	n := len(arr)
+	for i := 0; i < n-1; i++ {
+		// Find maximum element in unsorted portion
+		maxIdx := i
+		for j := i + 1; j < n; j++ {
+			if arr[j] > arr[maxIdx] {
+				maxIdx = j
+			}
+		}
+		// Swap maximum with first unsorted element
+		arr[i], arr[maxIdx] = arr[maxIdx], arr[i]
+	}
+}
+
+// SelectionSortBidirectional is bidirectional (cocktail) selection sort.
+func SelectionSortBidirectional[T cmp.Ordered](arr []T) {
+	selectionSortBidirectional(arr, cmp.Less[T], nil)
+}
+
+// SelectionSortBidirectionalFunc is SelectionSortBidirectional ordered by
+// less.
+func SelectionSortBidirectionalFunc[T any](arr []T, less func(a, b T) bool) {
+	selectionSortBidirectional(arr, less, nil)
+}
+
+func selectionSortBidirectional[T any](arr []T, less func(a, b T) bool, p *instrument.Probe) {
+	n := len(arr)
+	left := 0
+	right := n - 1
+
+	for left < right {
+		// Find minimum in current range
+		minIdx := left
+		maxIdx := left
+
+		for i := left; i <= right; i++ {
+			p.Compare(i, minIdx)
+			if less(arr[i], arr[minIdx]) {
+				minIdx = i
+			}
+			p.Compare(maxIdx, i)
+			if less(arr[maxIdx], arr[i]) {
+				maxIdx = i
+			}
+		}
+
+		// Swap minimum to left
+		arr[left], arr[minIdx] = arr[minIdx], arr[left]
+		p.Swap(left, minIdx)
+
+		// If max was at left position, it's now at minIdx
+		if maxIdx == left {
+			maxIdx = minIdx
+		}
+
+		// Swap maximum to right
+		arr[right], arr[maxIdx] = arr[maxIdx], arr[right]
+		p.Swap(right, maxIdx)
+
+		left++
+		right--
+	}
+}
+
+// SelectionSortRecursive sorts arr[start:] recursively.
+func SelectionSortRecursive[T cmp.Ordered](arr []T, start int) {
+	selectionSortRecursive(arr, start, cmp.Less[T], nil)
+}
+
+// SelectionSortRecursiveFunc is SelectionSortRecursive ordered by less.
+func SelectionSortRecursiveFunc[T any](arr []T, start int, less func(a, b T) bool) {
+	selectionSortRecursive(arr, start, less, nil)
+}
+
+func selectionSortRecursive[T any](arr []T, start int, less func(a, b T) bool, p *instrument.Probe) {
+	p.Enter()
+	defer p.Leave()
+
+	n := len(arr)
+
+	// Base case
+	if start >= n-1 {
+		return
+	}
+
+	// Find minimum in remaining array
+	minIdx := start
+	for i := start + 1; i < n; i++ {
+		p.Compare(i, minIdx)
+		if less(arr[i], arr[minIdx]) {
+			minIdx = i
+		}
+	}
+
+	// Swap
+	arr[start], arr[minIdx] = arr[minIdx], arr[start]
+	p.Swap(start, minIdx)
+
+	// Recurse for remaining array
+	selectionSortRecursive(arr, start+1, less, p)
+}
+
+// SelectionSortStable is stable selection sort (maintains relative order of
+// equal elements).
+func SelectionSortStable[T cmp.Ordered](arr []T) {
+	selectionSortStable(arr, cmp.Less[T], nil)
+}
+
+// SelectionSortStableFunc is SelectionSortStable ordered by less.
+func SelectionSortStableFunc[T any](arr []T, less func(a, b T) bool) {
+	selectionSortStable(arr, less, nil)
+}
+
+func selectionSortStable[T any](arr []T, less func(a, b T) bool, p *instrument.Probe) {
+	n := len(arr)
+	for i := 0; i < n-1; i++ {
+		// Find minimum
+		minIdx := i
+		for j := i + 1; j < n; j++ {
+			p.Compare(j, minIdx)
+			if less(arr[j], arr[minIdx]) {
+				minIdx = j
+			}
+		}
+
+		// Instead of swapping, shift elements to maintain stability
+		key := arr[minIdx]
+		for minIdx > i {
+			arr[minIdx] = arr[minIdx-1]
+			p.Write(minIdx)
+			minIdx--
+		}
+		arr[i] = key
+		p.Write(i)
+	}
+}
+
+// SelectionSortEarlyTermination is selection sort that stops once the
+// unsorted part is found to be in order.
+func SelectionSortEarlyTermination[T cmp.Ordered](arr []T) {
+	selectionSortEarlyTermination(arr, cmp.Less[T], nil)
+}
+
+// SelectionSortEarlyTerminationFunc is SelectionSortEarlyTermination ordered
+// by less.
+func SelectionSortEarlyTerminationFunc[T any](arr []T, less func(a, b T) bool) {
+	selectionSortEarlyTermination(arr, less, nil)
+}
+
+func selectionSortEarlyTermination[T any](arr []T, less func(a, b T) bool, p *instrument.Probe) {
+	n := len(arr)
+	for i := 0; i < n-1; i++ {
+		minIdx := i
+		isSorted := true
+
+		// Find minimum and check if the rest is sorted
+		for j := i + 1; j < n; j++ {
+			p.Compare(j, j-1)
+			if less(arr[j], arr[j-1]) {
+				isSorted = false
+			}
+			p.Compare(j, minIdx)
+			if less(arr[j], arr[minIdx]) {
+				minIdx = j
+			}
+		}
+
+		// If the rest is already sorted, we're done
+		if isSorted {
+			break
+		}
+
+		arr[i], arr[minIdx] = arr[minIdx], arr[i]
+		p.Swap(i, minIdx)
+	}
+}
+
+// SelectionSortCustom is selection sort ordered by comp.
+func SelectionSortCustom[T any](arr []T, comp comparator.Func[T]) {
+	selectionSortBasic(arr, comp.Less, nil)
+}
+
+// SelectionSortObjects sorts people by Age.
+func SelectionSortObjects(arr []records.Person) {
+	selectionSortBasic(arr, func(a, b records.Person) bool {
+		return a.Age < b.Age
+	}, nil)
+}
+
+// SelectionSortOptimized is selection sort that skips the swap when the
+// minimum is already in position.
+func SelectionSortOptimized[T cmp.Ordered](arr []T) {
+	selectionSortOptimized(arr, cmp.Less[T], nil)
+}
+
+// SelectionSortOptimizedFunc is SelectionSortOptimized ordered by less.
+func SelectionSortOptimizedFunc[T any](arr []T, less func(a, b T) bool) {
+	selectionSortOptimized(arr, less, nil)
+}
+
+func selectionSortOptimized[T any](arr []T, less func(a, b T) bool, p *instrument.Probe) {
+	n := len(arr)
+	for i := 0; i < n-1; i++ {
+		minIdx := i
+		for j := i + 1; j < n; j++ {
+			p.Compare(j, minIdx)
+			if less(arr[j], arr[minIdx]) {
+				minIdx = j
+			}
+		}
+
+		// Only swap if minimum is not already in position
+		if minIdx != i {
+			arr[i], arr[minIdx] = arr[minIdx], arr[i]
+			p.Swap(i, minIdx)
+		}
+	}
+}
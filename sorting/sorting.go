@@ -0,0 +1,47 @@
+// Package sorting implements the bubble, insertion, selection, quick, merge,
+// counting, radix and bucket sort variants.
+//
+// Functions that take low/high (or left/right) bounds sort the inclusive
+// range arr[low..high]; the rest sort the whole slice. Variants that return a
+// slice may reuse arr's backing array, so callers should use the result.
+//
+// Every comparison sort comes in two forms: Xxx sorts any cmp.Ordered
+// element type in ascending order, and XxxFunc orders elements by a
+// caller-supplied less function, which must be a strict weak ordering.
+// Floating-point NaNs sort before every other value, as with cmp.Less. The
+// XxxCustom variants take a comparator.Func instead.
+//
+// The counting and radix sorts documented as for non-negative integers
+// panic if given a negative value.
+package sorting
+
+import (
+	"fmt"
+
+	"github.com/RaviParvadiya/learn_dsa/instrument"
+)
+
+func swap[T any](arr []T, first, second int) {
+	arr[first], arr[second] = arr[second], arr[first]
+}
+
+// copyInto copies src into arr starting at off, recording each store on p.
+func copyInto[T any](arr []T, off int, src []T, p *instrument.Probe) {
+	copy(arr[off:], src)
+	if p != nil {
+		for i := range src {
+			p.Write(off + i)
+		}
+	}
+}
+
+// mustNonNegative panics if arr holds a negative value. The sorts of
+// non-negative integers call it first, as negative values would otherwise
+// index out of range or come out misordered.
+func mustNonNegative(name string, arr []int) {
+	for _, v := range arr {
+		if v < 0 {
+			panic(fmt.Sprintf("sorting: %s of negative value %d", name, v))
+		}
+	}
+}
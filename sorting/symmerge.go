@@ -0,0 +1,152 @@
+package sorting
+
+import (
+	"cmp"
+
+	"github.com/RaviParvadiya/learn_dsa/instrument"
+)
+
+// symMergeRun is the length of the runs symMergeSort insertion sorts
+// before it starts merging.
+const symMergeRun = 20
+
+// symMergeSort insertion sorts runs of symMergeRun elements, then merges
+// neighbouring runs bottom-up with symMerge. It is stable and allocates
+// nothing, and makes O(n log n) comparisons and O(n log² n) swaps.
+func symMergeSort[T any](arr []T, less func(a, b T) bool, p *instrument.Probe) {
+	n := len(arr)
+	for a := 0; a < n; a += symMergeRun {
+		insertionSort(arr, a, min(a+symMergeRun, n)-1, less, p)
+	}
+	for size := symMergeRun; size < n; size *= 2 {
+		for a := 0; a+size < n; a += 2 * size {
+			symMerge(arr, a, a+size, min(a+2*size, n), less, p)
+		}
+	}
+}
+
+// SymMerge merges the sorted runs arr[:mid] and arr[mid:] in place. It is
+// stable and allocates nothing: instead of copying into a buffer it rotates
+// elements between the runs, making O(m log(n/m + 1)) comparisons and
+// O((m+n) log m) swaps, where m is the length of the shorter run and n of
+// the longer.
+//
+// The algorithm is SymMerge from Kim and Kutzner, "Stable Minimum Storage
+// Merging by Symmetric Comparisons" (2004).
+func SymMerge[T cmp.Ordered](arr []T, mid int) {
+	if 0 < mid && mid < len(arr) {
+		symMerge(arr, 0, mid, len(arr), cmp.Less[T], nil)
+	}
+}
+
+// SymMergeFunc is SymMerge ordered by less.
+func SymMergeFunc[T any](arr []T, mid int, less func(a, b T) bool) {
+	if 0 < mid && mid < len(arr) {
+		symMerge(arr, 0, mid, len(arr), less, nil)
+	}
+}
+
+// symMerge merges the sorted runs arr[a:m] and arr[m:b], both non-empty.
+//
+// It takes the middle of arr[a:b] and finds, by binary search, the split
+// arr[a:start] + arr[start:m] and arr[m:end] + arr[end:b] of the runs such
+// that rotating arr[start:end] leaves everything before the middle no
+// greater than everything after it. Both halves are then merged
+// recursively.
+func symMerge[T any](arr []T, a, m, b int, less func(a, b T) bool, p *instrument.Probe) {
+	p.Enter()
+	defer p.Leave()
+	p.Merge(a, m-1, b-1)
+
+	// A lone element is moved straight into place.
+	if m-a == 1 {
+		// Find the first arr[i] >= arr[a] in arr[m:b].
+		i, j := m, b
+		for i < j {
+			h := int(uint(i+j) >> 1)
+			p.Compare(h, a)
+			if less(arr[h], arr[a]) {
+				i = h + 1
+			} else {
+				j = h
+			}
+		}
+		for k := a; k < i-1; k++ {
+			arr[k], arr[k+1] = arr[k+1], arr[k]
+			p.Swap(k, k+1)
+		}
+		return
+	}
+	if b-m == 1 {
+		// Find the first arr[i] > arr[m] in arr[a:m].
+		i, j := a, m
+		for i < j {
+			h := int(uint(i+j) >> 1)
+			p.Compare(m, h)
+			if !less(arr[m], arr[h]) {
+				i = h + 1
+			} else {
+				j = h
+			}
+		}
+		for k := m; k > i; k-- {
+			arr[k], arr[k-1] = arr[k-1], arr[k]
+			p.Swap(k, k-1)
+		}
+		return
+	}
+
+	mid := int(uint(a+b) >> 1)
+	n := mid + m
+	var start, r int
+	if m > mid {
+		start, r = n-b, mid
+	} else {
+		start, r = a, m
+	}
+	last := n - 1
+	for start < r {
+		c := int(uint(start+r) >> 1)
+		p.Compare(last-c, c)
+		if !less(arr[last-c], arr[c]) {
+			start = c + 1
+		} else {
+			r = c
+		}
+	}
+
+	end := n - start
+	if start < m && m < end {
+		rotateRange(arr, start, m, end, p)
+	}
+	if a < start && start < mid {
+		symMerge(arr, a, start, mid, less, p)
+	}
+	if mid < end && end < b {
+		symMerge(arr, mid, end, b, less, p)
+	}
+}
+
+// rotateRange swaps the blocks arr[a:m] and arr[m:b] with a sequence of
+// block swaps, like Euclid's algorithm on their lengths.
+func rotateRange[T any](arr []T, a, m, b int, p *instrument.Probe) {
+	i, j := m-a, b-m
+	for i != j {
+		if i > j {
+			swapRange(arr, m-i, m, j, p)
+			i -= j
+		} else {
+			swapRange(arr, m-i, m+j-i, i, p)
+			j -= i
+		}
+	}
+	swapRange(arr, m-i, m, i, p)
+}
+
+// swapRange swaps arr[a:a+n] with arr[b:b+n].
+func swapRange[T any](arr []T, a, b, n int, p *instrument.Probe) {
+	for i := 0; i < n; i++ {
+		arr[a+i], arr[b+i] = arr[b+i], arr[a+i]
+		p.Swap(a+i, b+i)
+	}
+}
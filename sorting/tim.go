@@ -0,0 +1,511 @@
+package sorting
+
+import (
+	"cmp"
+
+	"github.com/RaviParvadiya/learn_dsa/instrument"
+)
+
+// Timsort is the stable merge sort of Python and Java. Like
+// MergeSortNatural it merges the ascending runs already present in arr, but
+// it also reverses descending runs, extends runs shorter than a minimum
+// length with binary insertion sort, keeps the pending runs balanced on a
+// stack, and gallops through a merge while one run keeps winning. Sorted,
+// reversed and nearly-sorted input sort in close to linear time.
+func Timsort[T cmp.Ordered](arr []T) {
+	timsort(arr, cmp.Less[T], nil)
+}
+
+// TimsortFunc is Timsort ordered by less.
+func TimsortFunc[T any](arr []T, less func(a, b T) bool) {
+	timsort(arr, less, nil)
+}
+
+const (
+	timMinMerge  = 32 // shorter slices are sorted by binary insertion alone
+	timMinGallop = 7  // initial wins in a row before galloping
+)
+
+// timRun is a sorted run arr[base:base+len] waiting to be merged.
+type timRun struct {
+	base, len int
+}
+
+// timState is a Timsort in progress.
+type timState[T any] struct {
+	arr       []T
+	less      func(a, b T) bool
+	p         *instrument.Probe
+	minGallop int
+	tmp       []T      // scratch space for the smaller run of a merge
+	runs      []timRun // pending runs, lengths decreasing from bottom to top
+}
+
+func timsort[T any](arr []T, less func(a, b T) bool, p *instrument.Probe) {
+	n := len(arr)
+	if n < 2 {
+		return
+	}
+	if n < timMinMerge {
+		binaryInsertion(arr, 0, countRun(arr, 0, n, less, p), n, less, p)
+		return
+	}
+
+	ts := &timState[T]{arr: arr, less: less, p: p, minGallop: timMinGallop}
+	minRun := minRunLength(n)
+	for lo := 0; lo < n; {
+		runLen := countRun(arr, lo, n, less, p)
+		if runLen < minRun {
+			force := min(n-lo, minRun)
+			binaryInsertion(arr, lo, lo+runLen, lo+force, less, p)
+			runLen = force
+		}
+		ts.runs = append(ts.runs, timRun{lo, runLen})
+		ts.mergeCollapse()
+		lo += runLen
+	}
+	ts.mergeForceCollapse()
+}
+
+// minRunLength returns the shortest run worth merging for n elements: a
+// length between timMinMerge/2 and timMinMerge such that n divided by it is
+// a power of two or a little less, so the final merges are balanced.
+func minRunLength(n int) int {
+	r := 0
+	for n >= timMinMerge {
+		r |= n & 1
+		n >>= 1
+	}
+	return n + r
+}
+
+// countRun returns the length of the run starting at arr[lo], within
+// arr[lo:hi], reversing it first if it is strictly descending. Strictness
+// keeps the reversal from reordering equal elements.
+func countRun[T any](arr []T, lo, hi int, less func(a, b T) bool, p *instrument.Probe) int {
+	runHi := lo + 1
+	if runHi == hi {
+		return 1
+	}
+	p.Compare(runHi, lo)
+	if less(arr[runHi], arr[lo]) {
+		for runHi++; runHi < hi; runHi++ {
+			p.Compare(runHi, runHi-1)
+			if !less(arr[runHi], arr[runHi-1]) {
+				break
+			}
+		}
+		reverseRange(arr, lo, runHi, p)
+	} else {
+		for runHi++; runHi < hi; runHi++ {
+			p.Compare(runHi, runHi-1)
+			if less(arr[runHi], arr[runHi-1]) {
+				break
+			}
+		}
+	}
+	return runHi - lo
+}
+
+// mergeCollapse merges runs on top of the stack until, for every three
+// consecutive runs X, Y, Z from the top, len(Z) > len(Y)+len(X) and
+// len(Y) > len(X). This keeps the stack O(log n) deep and the merges
+// balanced.
+func (ts *timState[T]) mergeCollapse() {
+	for len(ts.runs) > 1 {
+		r := ts.runs
+		n := len(r) - 2
+		if n > 0 && r[n-1].len <= r[n].len+r[n+1].len ||
+			n > 1 && r[n-2].len <= r[n-1].len+r[n].len {
+			if r[n-1].len < r[n+1].len {
+				n--
+			}
+		} else if r[n].len > r[n+1].len {
+			break
+		}
+		ts.mergeAt(n)
+	}
+}
+
+// mergeForceCollapse merges all the runs left on the stack.
+func (ts *timState[T]) mergeForceCollapse() {
+	for len(ts.runs) > 1 {
+		n := len(ts.runs) - 2
+		if n > 0 && ts.runs[n-1].len < ts.runs[n+1].len {
+			n--
+		}
+		ts.mergeAt(n)
+	}
+}
+
+// mergeAt merges runs i and i+1 of the stack.
+func (ts *timState[T]) mergeAt(i int) {
+	arr := ts.arr
+	base1, len1 := ts.runs[i].base, ts.runs[i].len
+	base2, len2 := ts.runs[i+1].base, ts.runs[i+1].len
+	ts.p.Merge(base1, base2-1, base2+len2-1)
+
+	ts.runs[i].len = len1 + len2
+	if i == len(ts.runs)-3 {
+		ts.runs[i+1] = ts.runs[i+2]
+	}
+	ts.runs = ts.runs[:len(ts.runs)-1]
+
+	// Elements of run 1 before the first of run 2 are already in place,
+	// as are elements of run 2 after the last of run 1.
+	k := ts.gallopRight(arr[base2], base2, arr, true, base1, len1, 0)
+	base1 += k
+	len1 -= k
+	if len1 == 0 {
+		return
+	}
+	len2 = ts.gallopLeft(arr[base1+len1-1], base1+len1-1, arr, true, base2, len2, len2-1)
+	if len2 == 0 {
+		return
+	}
+
+	if len1 <= len2 {
+		ts.mergeLo(base1, len1, base2, len2)
+	} else {
+		ts.mergeHi(base1, len1, base2, len2)
+	}
+}
+
+// at returns the position of a[i] to report to the probe: i itself if a is
+// the slice being sorted, or -1 for the scratch buffer.
+func at(i int, inArr bool) int {
+	if inArr {
+		return i
+	}
+	return -1
+}
+
+// gallopLeft returns the index k in [0, length] at which key would be
+// inserted into the sorted a[base:base+length] before any equal elements,
+// searching outward from base+hint in steps of 1, 3, 7, ... before
+// bisecting. keyAt is the position of key in arr, or -1, and inArr tells
+// whether a is arr.
+func (ts *timState[T]) gallopLeft(key T, keyAt int, a []T, inArr bool, base, length, hint int) int {
+	less, p := ts.less, ts.p
+	// keyAfter reports whether key belongs after a[i].
+	keyAfter := func(i int) bool {
+		p.Compare(at(i, inArr), keyAt)
+		return less(a[i], key)
+	}
+
+	lastOfs, ofs := 0, 1
+	if keyAfter(base + hint) {
+		// Gallop right until a[base+hint+lastOfs] < key <= a[base+hint+ofs].
+		maxOfs := length - hint
+		for ofs < maxOfs && keyAfter(base+hint+ofs) {
+			lastOfs = ofs
+			ofs = ofs<<1 + 1
+		}
+		ofs = min(ofs, maxOfs)
+		lastOfs += hint
+		ofs += hint
+	} else {
+		// Gallop left until a[base+hint-ofs] < key <= a[base+hint-lastOfs].
+		maxOfs := hint + 1
+		for ofs < maxOfs && !keyAfter(base+hint-ofs) {
+			lastOfs = ofs
+			ofs = ofs<<1 + 1
+		}
+		ofs = min(ofs, maxOfs)
+		lastOfs, ofs = hint-ofs, hint-lastOfs
+	}
+
+	// Now a[base+lastOfs] < key <= a[base+ofs]; bisect in between.
+	for lastOfs++; lastOfs < ofs; {
+		m := lastOfs + (ofs-lastOfs)/2
+		if keyAfter(base + m) {
+			lastOfs = m + 1
+		} else {
+			ofs = m
+		}
+	}
+	return ofs
+}
+
+// gallopRight is gallopLeft inserting key after any equal elements.
+func (ts *timState[T]) gallopRight(key T, keyAt int, a []T, inArr bool, base, length, hint int) int {
+	less, p := ts.less, ts.p
+	// keyBefore reports whether key belongs before a[i].
+	keyBefore := func(i int) bool {
+		p.Compare(keyAt, at(i, inArr))
+		return less(key, a[i])
+	}
+
+	lastOfs, ofs := 0, 1
+	if keyBefore(base + hint) {
+		// Gallop left until a[base+hint-ofs] <= key < a[base+hint-lastOfs].
+		maxOfs := hint + 1
+		for ofs < maxOfs && keyBefore(base+hint-ofs) {
+			lastOfs = ofs
+			ofs = ofs<<1 + 1
+		}
+		ofs = min(ofs, maxOfs)
+		lastOfs, ofs = hint-ofs, hint-lastOfs
+	} else {
+		// Gallop right until a[base+hint+lastOfs] <= key < a[base+hint+ofs].
+		maxOfs := length - hint
+		for ofs < maxOfs && !keyBefore(base+hint+ofs) {
+			lastOfs = ofs
+			ofs = ofs<<1 + 1
+		}
+		ofs = min(ofs, maxOfs)
+		lastOfs += hint
+		ofs += hint
+	}
+
+	// Now a[base+lastOfs] <= key < a[base+ofs]; bisect in between.
+	for lastOfs++; lastOfs < ofs; {
+		m := lastOfs + (ofs-lastOfs)/2
+		if keyBefore(base + m) {
+			ofs = m
+		} else {
+			lastOfs = m + 1
+		}
+	}
+	return ofs
+}
+
+// scratch returns a buffer of at least n elements.
+func (ts *timState[T]) scratch(n int) []T {
+	if len(ts.tmp) < n {
+		size := max(n, min(2*len(ts.tmp), len(ts.arr)/2))
+		ts.tmp = make([]T, size)
+		ts.p.Alloc(size)
+	}
+	return ts.tmp
+}
+
+// put stores v at arr[i].
+func (ts *timState[T]) put(i int, v T) {
+	ts.arr[i] = v
+	ts.p.Write(i)
+}
+
+// mergeLo merges the adjacent runs arr[base1:base1+len1] and
+// arr[base2:base2+len2], where len1 <= len2, from the left, with run 1
+// copied aside. The first element of run 2 must belong before run 1 and the
+// last of run 1 after run 2, as mergeAt arranges.
+func (ts *timState[T]) mergeLo(base1, len1, base2, len2 int) {
+	arr, less, p := ts.arr, ts.less, ts.p
+	tmp := ts.scratch(len1)
+	copy(tmp, arr[base1:base1+len1])
+
+	cursor1, cursor2, dest := 0, base2, base1 // indexes into tmp, arr and arr
+	ts.put(dest, arr[cursor2])
+	dest++
+	cursor2++
+	if len2--; len2 == 0 {
+		copyInto(arr, dest, tmp[cursor1:cursor1+len1], p)
+		return
+	}
+	if len1 == 1 {
+		copyInto(arr, dest, arr[cursor2:cursor2+len2], p)
+		ts.put(dest+len2, tmp[cursor1])
+		return
+	}
+
+	minGallop := ts.minGallop
+outer:
+	for {
+		count1, count2 := 0, 0 // wins in a row of each run
+
+		// Merge one element at a time until one run keeps winning.
+		for {
+			p.Compare(cursor2, -1)
+			if less(arr[cursor2], tmp[cursor1]) {
+				ts.put(dest, arr[cursor2])
+				dest++
+				cursor2++
+				count2++
+				count1 = 0
+				if len2--; len2 == 0 {
+					break outer
+				}
+			} else {
+				ts.put(dest, tmp[cursor1])
+				dest++
+				cursor1++
+				count1++
+				count2 = 0
+				if len1--; len1 == 1 {
+					break outer
+				}
+			}
+			if count1|count2 >= minGallop {
+				break
+			}
+		}
+
+		// Gallop: find how far each run wins and move that many at once,
+		// until galloping stops paying off.
+		for {
+			count1 = ts.gallopRight(arr[cursor2], cursor2, tmp, false, cursor1, len1, 0)
+			if count1 != 0 {
+				copyInto(arr, dest, tmp[cursor1:cursor1+count1], p)
+				dest += count1
+				cursor1 += count1
+				len1 -= count1
+				if len1 <= 1 {
+					break outer
+				}
+			}
+			ts.put(dest, arr[cursor2])
+			dest++
+			cursor2++
+			if len2--; len2 == 0 {
+				break outer
+			}
+
+			count2 = ts.gallopLeft(tmp[cursor1], -1, arr, true, cursor2, len2, 0)
+			if count2 != 0 {
+				copyInto(arr, dest, arr[cursor2:cursor2+count2], p)
+				dest += count2
+				cursor2 += count2
+				len2 -= count2
+				if len2 == 0 {
+					break outer
+				}
+			}
+			ts.put(dest, tmp[cursor1])
+			dest++
+			cursor1++
+			if len1--; len1 == 1 {
+				break outer
+			}
+
+			minGallop--
+			if count1 < timMinGallop && count2 < timMinGallop {
+				break
+			}
+		}
+		// Galloping more readily after it paid off, less after it did not.
+		minGallop = max(minGallop, 0) + 2
+	}
+	ts.minGallop = max(minGallop, 1)
+
+	switch {
+	case len1 == 1:
+		copyInto(arr, dest, arr[cursor2:cursor2+len2], p)
+		ts.put(dest+len2, tmp[cursor1])
+	case len1 == 0:
+		panic("sorting: Timsort less is not a strict weak ordering")
+	default:
+		copyInto(arr, dest, tmp[cursor1:cursor1+len1], p)
+	}
+}
+
+// mergeHi is mergeLo for len1 >= len2, merging from the right with run 2
+// copied aside.
+func (ts *timState[T]) mergeHi(base1, len1, base2, len2 int) {
+	arr, less, p := ts.arr, ts.less, ts.p
+	tmp := ts.scratch(len2)
+	copy(tmp, arr[base2:base2+len2])
+
+	cursor1, cursor2, dest := base1+len1-1, len2-1, base2+len2-1 // indexes into arr, tmp and arr
+	ts.put(dest, arr[cursor1])
+	dest--
+	cursor1--
+	if len1--; len1 == 0 {
+		copyInto(arr, dest-(len2-1), tmp[:len2], p)
+		return
+	}
+	if len2 == 1 {
+		dest -= len1
+		cursor1 -= len1
+		copyInto(arr, dest+1, arr[cursor1+1:cursor1+1+len1], p)
+		ts.put(dest, tmp[cursor2])
+		return
+	}
+
+	minGallop := ts.minGallop
+outer:
+	for {
+		count1, count2 := 0, 0
+
+		for {
+			p.Compare(-1, cursor1)
+			if less(tmp[cursor2], arr[cursor1]) {
+				ts.put(dest, arr[cursor1])
+				dest--
+				cursor1--
+				count1++
+				count2 = 0
+				if len1--; len1 == 0 {
+					break outer
+				}
+			} else {
+				ts.put(dest, tmp[cursor2])
+				dest--
+				cursor2--
+				count2++
+				count1 = 0
+				if len2--; len2 == 1 {
+					break outer
+				}
+			}
+			if count1|count2 >= minGallop {
+				break
+			}
+		}
+
+		for {
+			count1 = len1 - ts.gallopRight(tmp[cursor2], -1, arr, true, base1, len1, len1-1)
+			if count1 != 0 {
+				dest -= count1
+				cursor1 -= count1
+				len1 -= count1
+				copyInto(arr, dest+1, arr[cursor1+1:cursor1+1+count1], p)
+				if len1 == 0 {
+					break outer
+				}
+			}
+			ts.put(dest, tmp[cursor2])
+			dest--
+			cursor2--
+			if len2--; len2 == 1 {
+				break outer
+			}
+
+			count2 = len2 - ts.gallopLeft(arr[cursor1], cursor1, tmp, false, 0, len2, len2-1)
+			if count2 != 0 {
+				dest -= count2
+				cursor2 -= count2
+				len2 -= count2
+				copyInto(arr, dest+1, tmp[cursor2+1:cursor2+1+count2], p)
+				if len2 <= 1 {
+					break outer
+				}
+			}
+			ts.put(dest, arr[cursor1])
+			dest--
+			cursor1--
+			if len1--; len1 == 0 {
+				break outer
+			}
+
+			minGallop--
+			if count1 < timMinGallop && count2 < timMinGallop {
+				break
+			}
+		}
+		minGallop = max(minGallop, 0) + 2
+	}
+	ts.minGallop = max(minGallop, 1)
+
+	switch {
+	case len2 == 1:
+		dest -= len1
+		cursor1 -= len1
+		copyInto(arr, dest+1, arr[cursor1+1:cursor1+1+len1], p)
+		ts.put(dest, tmp[cursor2])
+	case len2 == 0:
+		panic("sorting: Timsort less is not a strict weak ordering")
+	default:
+		copyInto(arr, dest-(len2-1), tmp[:len2], p)
+	}
+}
@@ -0,0 +1,129 @@
+package trace
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// header is the first line of a JSON Lines trace.
+type header struct {
+	Algorithm string `json:"algorithm"`
+	Input     []int  `json:"input"`
+}
+
+// wireEvent is the JSON form of an Event, holding only the fields its Op
+// uses, e.g. {"op":"write","i":3,"v":17}.
+type wireEvent struct {
+	Op   Op   `json:"op"`
+	I    *int `json:"i,omitempty"`
+	J    *int `json:"j,omitempty"`
+	V    *int `json:"v,omitempty"`
+	Low  *int `json:"low,omitempty"`
+	Mid  *int `json:"mid,omitempty"`
+	High *int `json:"high,omitempty"`
+}
+
+// MarshalJSON encodes e with only the fields its Op uses.
+func (e Event) MarshalJSON() ([]byte, error) {
+	w := wireEvent{Op: e.Op}
+	switch e.Op {
+	case OpCompare, OpSwap:
+		w.I, w.J = &e.I, &e.J
+	case OpWrite:
+		w.I, w.V = &e.I, &e.V
+	case OpSplit:
+		w.Low, w.High = &e.Low, &e.High
+	case OpMerge:
+		w.Low, w.Mid, w.High = &e.Low, &e.Mid, &e.High
+	default:
+		return nil, fmt.Errorf("trace: unknown op %q", e.Op)
+	}
+	return json.Marshal(w)
+}
+
+// UnmarshalJSON decodes an event written by MarshalJSON, rejecting events
+// that lack a field their Op needs.
+func (e *Event) UnmarshalJSON(data []byte) error {
+	var w wireEvent
+	if err := json.Unmarshal(data, &w); err != nil {
+		return err
+	}
+	var need []*int
+	switch w.Op {
+	case OpCompare, OpSwap:
+		need = []*int{w.I, w.J}
+	case OpWrite:
+		need = []*int{w.I, w.V}
+	case OpSplit:
+		need = []*int{w.Low, w.High}
+	case OpMerge:
+		need = []*int{w.Low, w.Mid, w.High}
+	default:
+		return fmt.Errorf("trace: unknown op %q", w.Op)
+	}
+	for _, f := range need {
+		if f == nil {
+			return fmt.Errorf("trace: %s event is missing a field", w.Op)
+		}
+	}
+	*e = Event{
+		Op:   w.Op,
+		I:    value(w.I),
+		J:    value(w.J),
+		V:    value(w.V),
+		Low:  value(w.Low),
+		Mid:  value(w.Mid),
+		High: value(w.High),
+	}
+	return nil
+}
+
+func value(p *int) int {
+	if p == nil {
+		return 0
+	}
+	return *p
+}
+
+// WriteJSONL writes t as JSON Lines: a header line holding the algorithm
+// name and input, followed by one line per event.
+func (t *Trace) WriteJSONL(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+	if err := enc.Encode(header{Algorithm: t.Algorithm, Input: t.Input}); err != nil {
+		return err
+	}
+	for _, e := range t.Events {
+		if err := enc.Encode(e); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// ReadJSONL reads a trace written by WriteJSONL.
+func ReadJSONL(r io.Reader) (*Trace, error) {
+	dec := json.NewDecoder(r)
+	var h header
+	if err := dec.Decode(&h); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("trace: missing header")
+		}
+		return nil, fmt.Errorf("trace: header: %w", err)
+	}
+	t := &Trace{Algorithm: h.Algorithm, Input: h.Input}
+	for {
+		var e Event
+		err := dec.Decode(&e)
+		if errors.Is(err, io.EOF) {
+			return t, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("trace: event %d: %w", len(t.Events), err)
+		}
+		t.Events = append(t.Events, e)
+	}
+}
@@ -0,0 +1,126 @@
+// Package trace records the step-by-step execution of a sort as a stream of
+// events that can be saved as JSON Lines and replayed.
+//
+// A trace starts with the input and lists every compare, swap and write the
+// algorithm reported, together with the ranges it split and merged. Replaying
+// the swaps and writes against the input reproduces each intermediate state
+// and ends with the sorted output.
+package trace
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/RaviParvadiya/learn_dsa/instrument"
+)
+
+// Op names the kind of an Event.
+type Op string
+
+// The event kinds.
+const (
+	OpCompare Op = "compare" // I and J were compared
+	OpSwap    Op = "swap"    // I and J were exchanged
+	OpWrite   Op = "write"   // V was stored at I
+	OpSplit   Op = "split"   // [Low, High] is about to be divided
+	OpMerge   Op = "merge"   // [Low, Mid] and [Mid+1, High] are about to be merged
+)
+
+// Event is one step of a trace. Only the fields used by its Op are
+// meaningful; an index of -1 stands for a value held outside the slice.
+type Event struct {
+	Op   Op
+	I, J int
+	V    int
+	Low  int
+	Mid  int
+	High int
+}
+
+func (e Event) String() string {
+	switch e.Op {
+	case OpCompare, OpSwap:
+		return fmt.Sprintf("%s(%d, %d)", e.Op, e.I, e.J)
+	case OpWrite:
+		return fmt.Sprintf("%s(%d, %d)", e.Op, e.I, e.V)
+	case OpSplit:
+		return fmt.Sprintf("%s(%d, %d)", e.Op, e.Low, e.High)
+	case OpMerge:
+		return fmt.Sprintf("%s(%d, %d, %d)", e.Op, e.Low, e.Mid, e.High)
+	}
+	return string(e.Op)
+}
+
+// Trace is the recorded execution of one algorithm on one input.
+type Trace struct {
+	Algorithm string
+	Input     []int
+	Events    []Event
+}
+
+// Record runs sort on a copy of input and returns the trace of the run
+// along with its operation counts.
+func Record(algorithm string, input []int, sort func(arr []int, p *instrument.Probe)) (*Trace, instrument.Stats) {
+	t := &Trace{Algorithm: algorithm, Input: slices.Clone(input)}
+	r := &recorder{arr: slices.Clone(input), t: t}
+	p := &instrument.Probe{Observer: r}
+	sort(r.arr, p)
+	return t, p.Stats
+}
+
+// recorder is the instrument.Observer behind Record. It reads written
+// values from the slice being sorted.
+type recorder struct {
+	arr []int
+	t   *Trace
+}
+
+func (r *recorder) add(e Event) {
+	r.t.Events = append(r.t.Events, e)
+}
+
+func (r *recorder) Compare(i, j int) { r.add(Event{Op: OpCompare, I: i, J: j}) }
+func (r *recorder) Swap(i, j int)    { r.add(Event{Op: OpSwap, I: i, J: j}) }
+func (r *recorder) Write(i int)      { r.add(Event{Op: OpWrite, I: i, V: r.arr[i]}) }
+
+func (r *recorder) Split(low, high int) {
+	r.add(Event{Op: OpSplit, Low: low, High: high})
+}
+
+func (r *recorder) Merge(low, mid, high int) {
+	r.add(Event{Op: OpMerge, Low: low, Mid: mid, High: high})
+}
+
+// Replay applies the swaps and writes of t to a copy of its input, calling
+// fn (if non-nil) after every event with the event's index and the state at
+// that point, and returns the final state. If fn returns false Replay stops
+// there and returns the state at that point. fn must not retain or modify
+// arr. Replay fails if an event refers to a position outside the input.
+func (t *Trace) Replay(fn func(step int, e Event, arr []int) bool) ([]int, error) {
+	arr := slices.Clone(t.Input)
+	for step, e := range t.Events {
+		switch e.Op {
+		case OpSwap:
+			if !inRange(arr, e.I) || !inRange(arr, e.J) {
+				return nil, fmt.Errorf("trace: event %d: %v out of range", step, e)
+			}
+			arr[e.I], arr[e.J] = arr[e.J], arr[e.I]
+		case OpWrite:
+			if !inRange(arr, e.I) {
+				return nil, fmt.Errorf("trace: event %d: %v out of range", step, e)
+			}
+			arr[e.I] = e.V
+		case OpCompare, OpSplit, OpMerge:
+		default:
+			return nil, fmt.Errorf("trace: event %d: unknown op %q", step, e.Op)
+		}
+		if fn != nil && !fn(step, e, arr) {
+			break
+		}
+	}
+	return arr, nil
+}
+
+func inRange(arr []int, i int) bool {
+	return i >= 0 && i < len(arr)
+}
@@ -0,0 +1,204 @@
+package trace_test
+
+import (
+	"bytes"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/RaviParvadiya/learn_dsa/sorting"
+	"github.com/RaviParvadiya/learn_dsa/trace"
+)
+
+// everyOp is a trace holding each kind of event, with zero and -1 indices
+// that a careless encoding would drop.
+var everyOp = &trace.Trace{
+	Algorithm: "Test",
+	Input:     []int{3, 0, -2},
+	Events: []trace.Event{
+		{Op: trace.OpCompare, I: 0, J: 1},
+		{Op: trace.OpCompare, I: 2, J: -1},
+		{Op: trace.OpSwap, I: 0, J: 2},
+		{Op: trace.OpWrite, I: 1, V: 0},
+		{Op: trace.OpWrite, I: 0, V: -7},
+		{Op: trace.OpSplit, Low: 0, High: 2},
+		{Op: trace.OpMerge, Low: 0, Mid: 0, High: 2},
+	},
+}
+
+func TestJSONLRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	if err := everyOp.WriteJSONL(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if lines := strings.Count(buf.String(), "\n"); lines != 1+len(everyOp.Events) {
+		t.Errorf("wrote %d lines, want a header and one per event", lines)
+	}
+	got, err := trace.ReadJSONL(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, everyOp) {
+		t.Errorf("read back %+v, want %+v", got, everyOp)
+	}
+}
+
+func TestEventEncoding(t *testing.T) {
+	tests := []struct {
+		e    trace.Event
+		want string
+	}{
+		{trace.Event{Op: trace.OpCompare, I: 0, J: -1, V: 9}, `{"op":"compare","i":0,"j":-1}`},
+		{trace.Event{Op: trace.OpSwap, I: 4, J: 2}, `{"op":"swap","i":4,"j":2}`},
+		{trace.Event{Op: trace.OpWrite, I: 3, V: 17, Low: 1}, `{"op":"write","i":3,"v":17}`},
+		{trace.Event{Op: trace.OpSplit, Low: 0, High: 9}, `{"op":"split","low":0,"high":9}`},
+		{trace.Event{Op: trace.OpMerge, Low: 0, Mid: 4, High: 9}, `{"op":"merge","low":0,"mid":4,"high":9}`},
+	}
+	for _, tt := range tests {
+		got, err := tt.e.MarshalJSON()
+		if err != nil || string(got) != tt.want {
+			t.Errorf("MarshalJSON(%v) = %s, %v, want %s", tt.e, got, err, tt.want)
+		}
+	}
+	if _, err := (trace.Event{Op: "peek"}).MarshalJSON(); err == nil {
+		t.Error("MarshalJSON of an unknown op succeeded")
+	}
+}
+
+func TestUnmarshalRejects(t *testing.T) {
+	for _, line := range []string{
+		`{"op":"peek","i":1,"j":2}`,
+		`{"i":1,"j":2}`,
+		`{"op":"compare","i":1}`,
+		`{"op":"swap","j":1}`,
+		`{"op":"write","i":1}`,
+		`{"op":"split","low":0}`,
+		`{"op":"merge","low":0,"high":4}`,
+		`{"op":"compare","i":"1","j":2}`,
+		`{"op":"compare",`,
+		`[1,2]`,
+	} {
+		var e trace.Event
+		if err := e.UnmarshalJSON([]byte(line)); err == nil {
+			t.Errorf("UnmarshalJSON(%s) succeeded with %+v", line, e)
+		}
+	}
+}
+
+func TestReadJSONLRejects(t *testing.T) {
+	const head = `{"algorithm":"Test","input":[2,1]}` + "\n"
+	for name, input := range map[string]string{
+		"empty":          "",
+		"bad header":     `{"algorithm":` + "\n",
+		"unknown op":     head + `{"op":"peek","i":0,"j":1}` + "\n",
+		"missing field":  head + `{"op":"swap","i":0}` + "\n",
+		"malformed line": head + `{"op":"swap","i":0,"j":1}` + "\n" + `not json` + "\n",
+		"truncated line": head + `{"op":"swap","i":0,`,
+	} {
+		if tr, err := trace.ReadJSONL(strings.NewReader(input)); err == nil {
+			t.Errorf("%s: ReadJSONL succeeded with %+v", name, tr)
+		}
+	}
+
+	tr, err := trace.ReadJSONL(strings.NewReader(head))
+	if err != nil || len(tr.Events) != 0 || !slices.Equal(tr.Input, []int{2, 1}) {
+		t.Errorf("header only: got %+v, %v", tr, err)
+	}
+}
+
+func TestReplay(t *testing.T) {
+	var steps []int
+	got, err := everyOp.Replay(func(step int, e trace.Event, arr []int) bool {
+		steps = append(steps, step)
+		return true
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{-7, 0, 3}; !slices.Equal(got, want) {
+		t.Errorf("Replay = %v, want %v", got, want)
+	}
+	if len(steps) != len(everyOp.Events) {
+		t.Errorf("fn called %d times, want %d", len(steps), len(everyOp.Events))
+	}
+	if !slices.Equal(everyOp.Input, []int{3, 0, -2}) {
+		t.Errorf("Replay modified the input: %v", everyOp.Input)
+	}
+}
+
+func TestReplayStop(t *testing.T) {
+	var ops []trace.Op
+	got, err := everyOp.Replay(func(step int, e trace.Event, arr []int) bool {
+		ops = append(ops, e.Op)
+		return e.Op != trace.OpSwap
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []trace.Op{trace.OpCompare, trace.OpCompare, trace.OpSwap}; !slices.Equal(ops, want) {
+		t.Errorf("fn saw %v, want %v", ops, want)
+	}
+	if want := []int{-2, 0, 3}; !slices.Equal(got, want) {
+		t.Errorf("stopped at %v, want %v", got, want)
+	}
+}
+
+// TestReplayOutOfRange checks that events outside the input make Replay
+// fail rather than panic.
+func TestReplayOutOfRange(t *testing.T) {
+	for _, e := range []trace.Event{
+		{Op: trace.OpSwap, I: 0, J: 3},
+		{Op: trace.OpSwap, I: -1, J: 0},
+		{Op: trace.OpWrite, I: 3, V: 1},
+		{Op: trace.OpWrite, I: -1, V: 1},
+		{Op: "peek"},
+	} {
+		tr := &trace.Trace{Input: []int{1, 2, 3}, Events: []trace.Event{{Op: trace.OpSwap, I: 0, J: 1}, e}}
+		called := 0
+		arr, err := tr.Replay(func(int, trace.Event, []int) bool {
+			called++
+			return true
+		})
+		if err == nil {
+			t.Errorf("%v: Replay succeeded with %v", e, arr)
+		}
+		if called != 1 {
+			t.Errorf("%v: fn called %d times, want once before the bad event", e, called)
+		}
+	}
+}
+
+// TestRecord records real sorts, making swaps, writes, splits and merges,
+// and replays them to the sorted output.
+func TestRecord(t *testing.T) {
+	input := []int{5, 2, 9, 1, 5, 6}
+	names := []string{"InsertionSortBasic", "InsertionSortSwapping", "MergeSort1", "QuickSort"}
+	found := 0
+	for _, a := range sorting.Algorithms() {
+		if !slices.Contains(names, a.Name) {
+			continue
+		}
+		found++
+		tr, stats := trace.Record(a.Name, input, a.Sort)
+		var compares int
+		for _, e := range tr.Events {
+			if e.Op == trace.OpCompare {
+				compares++
+			}
+		}
+		if compares != stats.Comparisons {
+			t.Errorf("%s: %d compare events, %d comparisons counted", a.Name, compares, stats.Comparisons)
+		}
+		got, err := tr.Replay(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := []int{1, 2, 5, 5, 6, 9}; !slices.Equal(got, want) {
+			t.Errorf("%s: replayed to %v, want %v", a.Name, got, want)
+		}
+	}
+	if found != len(names) {
+		t.Errorf("found %d of the algorithms %v", found, names)
+	}
+}
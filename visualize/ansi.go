@@ -0,0 +1,91 @@
+package visualize
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+
+	"github.com/RaviParvadiya/learn_dsa/trace"
+)
+
+// ANSI escape sequences used by the terminal renderer.
+const (
+	ansiHome       = "\x1b[H"
+	ansiClear      = "\x1b[2J"
+	ansiClearLine  = "\x1b[K"
+	ansiHideCursor = "\x1b[?25l"
+	ansiShowCursor = "\x1b[?25h"
+	ansiReset      = "\x1b[0m"
+)
+
+// ansiColor is the color each Mark is drawn in.
+var ansiColor = [...]string{
+	None:     "",
+	Outside:  "\x1b[90m",
+	Compared: "\x1b[33m",
+	Changed:  "\x1b[31m",
+}
+
+// Terminal draws frames of one trace as bar charts on an ANSI terminal,
+// redrawing in place so consecutive frames animate.
+type Terminal struct {
+	w      *bufio.Writer
+	t      *trace.Trace
+	height int // rows of bars
+	max    int // value drawn as a full-height bar
+}
+
+// NewTerminal returns a Terminal drawing frames of t to w with bars up to
+// height rows tall.
+func NewTerminal(w io.Writer, t *trace.Trace, height int) *Terminal {
+	return &Terminal{w: bufio.NewWriter(w), t: t, height: max(height, 1), max: scale(t)}
+}
+
+// Start clears the screen and hides the cursor.
+func (term *Terminal) Start() error {
+	term.w.WriteString(ansiClear + ansiHideCursor)
+	return term.w.Flush()
+}
+
+// Stop shows the cursor again.
+func (term *Terminal) Stop() error {
+	term.w.WriteString(ansiReset + ansiShowCursor)
+	return term.w.Flush()
+}
+
+// Draw redraws the screen with f, followed by status as a last line.
+func (term *Terminal) Draw(f Frame, status string) error {
+	w := term.w
+	w.WriteString(ansiHome)
+	fmt.Fprintf(w, "%s  %d/%d  %s%s\n", term.t.Algorithm, f.Step+1, len(term.t.Events), eventLabel(f), ansiClearLine)
+	for row := term.height; row > 0; row-- {
+		color := ""
+		for i, v := range f.Values {
+			c := ansiColor[f.Highlight(i)]
+			if c != color {
+				w.WriteString(ansiReset + c)
+				color = c
+			}
+			if v*term.height >= row*term.max {
+				w.WriteString("█")
+			} else {
+				w.WriteByte(' ')
+			}
+		}
+		w.WriteString(ansiReset + ansiClearLine + "\n")
+	}
+	fmt.Fprintf(w, "%s%s", status, ansiClearLine)
+	return w.Flush()
+}
+
+// eventLabel describes the event that produced f.
+func eventLabel(f Frame) string {
+	if f.Step < 0 {
+		return "input"
+	}
+	s := f.Event.String()
+	if f.Low >= 0 {
+		s += fmt.Sprintf("  range [%d, %d]", f.Low, f.High)
+	}
+	return s
+}
@@ -0,0 +1,106 @@
+// Package visualize renders recorded sort traces as pictures: ANSI bar charts
+// for the terminal, animated GIFs and SVG filmstrips. The GIF and SVG
+// writers use only the standard library and need no display.
+package visualize
+
+import (
+	"slices"
+
+	"github.com/RaviParvadiya/learn_dsa/trace"
+)
+
+// Frame is the state of the slice after one event of a trace.
+type Frame struct {
+	Step   int         // index of Event in the trace, or -1 for the input
+	Event  trace.Event // the event that produced this state
+	Values []int
+
+	// Low and High bound the range most recently split or merged, or are
+	// both -1 when no range is active.
+	Low, High int
+}
+
+// Highlight reports how the event of f touched position i.
+func (f Frame) Highlight(i int) Mark {
+	if f.Step < 0 {
+		return None
+	}
+	e := f.Event
+	switch e.Op {
+	case trace.OpCompare:
+		if i == e.I || i == e.J {
+			return Compared
+		}
+	case trace.OpSwap:
+		if i == e.I || i == e.J {
+			return Changed
+		}
+	case trace.OpWrite:
+		if i == e.I {
+			return Changed
+		}
+	}
+	if f.Low >= 0 && (i < f.Low || i > f.High) {
+		return Outside
+	}
+	return None
+}
+
+// Mark is how a position is drawn in a frame.
+type Mark int
+
+// The marks, from least to most prominent.
+const (
+	None     Mark = iota // an ordinary bar
+	Outside              // outside the active range
+	Compared             // compared by the current event
+	Changed              // swapped or written by the current event
+)
+
+// Each replays t, calling fn with its input and then with the state after
+// each event, until fn returns false. If compares is false, compare events
+// are skipped. fn must not retain or modify the frame's Values.
+func Each(t *trace.Trace, compares bool, fn func(f Frame) bool) error {
+	if !fn(Frame{Step: -1, Values: t.Input, Low: -1, High: -1}) {
+		return nil
+	}
+	low, high := -1, -1
+	_, err := t.Replay(func(step int, e trace.Event, arr []int) bool {
+		switch e.Op {
+		case trace.OpSplit, trace.OpMerge:
+			low, high = e.Low, e.High
+		case trace.OpCompare:
+			if !compares {
+				return true
+			}
+		}
+		return fn(Frame{Step: step, Event: e, Values: arr, Low: low, High: high})
+	})
+	return err
+}
+
+// Frames is Each collecting every frame, for renderers that need the whole
+// run at once.
+func Frames(t *trace.Trace, compares bool) ([]Frame, error) {
+	var frames []Frame
+	err := Each(t, compares, func(f Frame) bool {
+		f.Values = slices.Clone(f.Values)
+		frames = append(frames, f)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return frames, nil
+}
+
+// scale returns the value drawn as a full-height bar: the largest input
+// value, or 1 if there is none larger. Sorting only moves values around, so
+// this holds for every frame of the run.
+func scale(t *trace.Trace) int {
+	m := 1
+	for _, v := range t.Input {
+		m = max(m, v)
+	}
+	return m
+}
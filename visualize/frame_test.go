@@ -0,0 +1,125 @@
+package visualize_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/RaviParvadiya/learn_dsa/trace"
+	"github.com/RaviParvadiya/learn_dsa/visualize"
+)
+
+// small is a trace with every kind of event: a compare and a swap within
+// a split range, then a compare reaching outside the merged range and a
+// write.
+var small = &trace.Trace{
+	Algorithm: "Test",
+	Input:     []int{3, 1, 2},
+	Events: []trace.Event{
+		{Op: trace.OpSplit, Low: 0, High: 1},
+		{Op: trace.OpCompare, I: 0, J: 1},
+		{Op: trace.OpSwap, I: 0, J: 1},
+		{Op: trace.OpMerge, Low: 0, Mid: 0, High: 1},
+		{Op: trace.OpCompare, I: 1, J: 2},
+		{Op: trace.OpWrite, I: 2, V: 4},
+	},
+}
+
+const (
+	N = visualize.None
+	O = visualize.Outside
+	C = visualize.Compared
+	X = visualize.Changed
+)
+
+func TestEach(t *testing.T) {
+	type frame struct {
+		step   int
+		values []int
+		marks  []visualize.Mark
+	}
+	all := []frame{
+		{-1, []int{3, 1, 2}, []visualize.Mark{N, N, N}},
+		{0, []int{3, 1, 2}, []visualize.Mark{N, N, O}},
+		{1, []int{3, 1, 2}, []visualize.Mark{C, C, O}},
+		{2, []int{1, 3, 2}, []visualize.Mark{X, X, O}},
+		{3, []int{1, 3, 2}, []visualize.Mark{N, N, O}},
+		{4, []int{1, 3, 2}, []visualize.Mark{N, C, C}},
+		{5, []int{1, 3, 4}, []visualize.Mark{N, N, X}},
+	}
+	for _, compares := range []bool{true, false} {
+		var want []frame
+		for _, f := range all {
+			if compares || f.step < 0 || small.Events[f.step].Op != trace.OpCompare {
+				want = append(want, f)
+			}
+		}
+		var got []frame
+		err := visualize.Each(small, compares, func(f visualize.Frame) bool {
+			marks := make([]visualize.Mark, len(f.Values))
+			for i := range marks {
+				marks[i] = f.Highlight(i)
+			}
+			got = append(got, frame{f.Step, slices.Clone(f.Values), marks})
+			return true
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != len(want) {
+			t.Fatalf("compares %t: %d frames, want %d", compares, len(got), len(want))
+		}
+		for i := range want {
+			g, w := got[i], want[i]
+			if g.step != w.step || !slices.Equal(g.values, w.values) || !slices.Equal(g.marks, w.marks) {
+				t.Errorf("compares %t: frame %d is %v, want %v", compares, i, g, w)
+			}
+		}
+	}
+}
+
+func TestEachStop(t *testing.T) {
+	var steps []int
+	err := visualize.Each(small, true, func(f visualize.Frame) bool {
+		steps = append(steps, f.Step)
+		return f.Step < 2
+	})
+	if err != nil || !slices.Equal(steps, []int{-1, 0, 1, 2}) {
+		t.Errorf("stopping after step 2: saw %v, %v", steps, err)
+	}
+
+	calls := 0
+	err = visualize.Each(small, true, func(visualize.Frame) bool {
+		calls++
+		return false
+	})
+	if err != nil || calls != 1 {
+		t.Errorf("stopping at the input: %d calls, %v", calls, err)
+	}
+}
+
+func TestEachError(t *testing.T) {
+	bad := &trace.Trace{Input: []int{1, 2}, Events: []trace.Event{{Op: trace.OpSwap, I: 0, J: 2}}}
+	if err := visualize.Each(bad, true, func(visualize.Frame) bool { return true }); err == nil {
+		t.Error("Each of a trace swapping past the input succeeded")
+	}
+	if frames, err := visualize.Frames(bad, true); err == nil {
+		t.Errorf("Frames of a bad trace succeeded with %v", frames)
+	}
+}
+
+// TestFrames checks that Frames keeps a copy of the values of every frame.
+func TestFrames(t *testing.T) {
+	frames, err := visualize.Frames(small, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(frames) != 5 {
+		t.Fatalf("%d frames, want 5", len(frames))
+	}
+	if !slices.Equal(frames[0].Values, []int{3, 1, 2}) || !slices.Equal(frames[2].Values, []int{1, 3, 2}) || !slices.Equal(frames[4].Values, []int{1, 3, 4}) {
+		t.Errorf("frames share their values: %v", frames)
+	}
+	if !slices.Equal(small.Input, []int{3, 1, 2}) {
+		t.Errorf("Frames modified the input: %v", small.Input)
+	}
+}
@@ -0,0 +1,62 @@
+package visualize
+
+import (
+	"image"
+	"image/color"
+	"image/gif"
+	"io"
+
+	"github.com/RaviParvadiya/learn_dsa/trace"
+)
+
+// gifPalette holds the background followed by the mark colors.
+var gifPalette = color.Palette{
+	background,
+	markColor[None],
+	markColor[Outside],
+	markColor[Compared],
+	markColor[Changed],
+}
+
+// WriteGIF writes t to w as an animated GIF of bar charts, sampling at most
+// opts.Frames frames evenly across the run. The sorted output is held for a
+// second at the end.
+func WriteGIF(w io.Writer, t *trace.Trace, opts Options) error {
+	opts = opts.withDefaults(300)
+	out, err := t.Replay(nil)
+	if err != nil {
+		return err
+	}
+
+	n := 1 // the input
+	for _, e := range t.Events {
+		if opts.Compares || e.Op != trace.OpCompare {
+			n++
+		}
+	}
+	keep := sample(n, opts.Frames-1) // leave room for the output
+	maxVal := scale(t)
+	delay := max(int(opts.Delay.Milliseconds()/10), 1)
+	anim := &gif.GIF{}
+	add := func(f Frame, delay int) {
+		img := image.NewPaletted(image.Rect(0, 0, opts.Width, opts.Height), gifPalette)
+		drawFrame(img, f, maxVal)
+		anim.Image = append(anim.Image, img)
+		anim.Delay = append(anim.Delay, delay)
+	}
+
+	k := 0
+	err = Each(t, opts.Compares, func(f Frame) bool {
+		if len(keep) > 0 && keep[0] == k {
+			add(f, delay)
+			keep = keep[1:]
+		}
+		k++
+		return len(keep) > 0
+	})
+	if err != nil {
+		return err
+	}
+	add(final(t, out), 100)
+	return gif.EncodeAll(w, anim)
+}
@@ -0,0 +1,94 @@
+package visualize
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"time"
+
+	"github.com/RaviParvadiya/learn_dsa/trace"
+)
+
+// Options sets the size and pacing of exported pictures. Zero fields take
+// the defaults noted.
+type Options struct {
+	Width, Height int           // size of one frame in pixels; 640x240
+	Delay         time.Duration // how long a GIF frame is shown; 40ms
+	Frames        int           // most frames to export; 300 for a GIF, 12 for an SVG
+	Compares      bool          // include compare events as frames
+}
+
+func (o Options) withDefaults(frames int) Options {
+	if o.Width <= 0 {
+		o.Width = 640
+	}
+	if o.Height <= 0 {
+		o.Height = 240
+	}
+	if o.Delay <= 0 {
+		o.Delay = 40 * time.Millisecond
+	}
+	if o.Frames <= 0 {
+		o.Frames = frames
+	}
+	return o
+}
+
+// markColor is the color each Mark is drawn in.
+var markColor = [...]color.RGBA{
+	None:     {0x4a, 0x6f, 0xa5, 0xff},
+	Outside:  {0xc8, 0xc8, 0xc8, 0xff},
+	Compared: {0xe6, 0xb4, 0x00, 0xff},
+	Changed:  {0xd6, 0x27, 0x28, 0xff},
+}
+
+var background = color.RGBA{0xff, 0xff, 0xff, 0xff}
+
+// bar is the rectangle of bar i of a frame with n bars, within a w by h
+// area, for a value v out of maxVal.
+func bar(i, n, v, maxVal, w, h int) image.Rectangle {
+	x0, x1 := i*w/n, (i+1)*w/n
+	if x1-x0 > 2 {
+		x1-- // leave a gap between wide bars
+	}
+	v = min(max(v, 0), maxVal)
+	return image.Rect(x0, h-v*h/maxVal, x1, h)
+}
+
+// drawFrame draws f onto img as a bar chart filling its bounds.
+func drawFrame(img draw.Image, f Frame, maxVal int) {
+	b := img.Bounds()
+	draw.Draw(img, b, image.NewUniform(background), image.Point{}, draw.Src)
+	for i, v := range f.Values {
+		r := bar(i, len(f.Values), v, maxVal, b.Dx(), b.Dy()).Add(b.Min)
+		draw.Draw(img, r, image.NewUniform(markColor[f.Highlight(i)]), image.Point{}, draw.Src)
+	}
+}
+
+// final is the frame showing the output of t, with nothing highlighted.
+func final(t *trace.Trace, out []int) Frame {
+	return Frame{Step: len(t.Events), Values: out, Low: -1, High: -1}
+}
+
+// sample picks at most k of the n items 0..n-1, evenly spaced and keeping
+// the first and last, or only the last if k is 1.
+func sample(n, k int) []int {
+	if k <= 0 {
+		return nil
+	}
+	if n <= k {
+		idx := make([]int, n)
+		for i := range idx {
+			idx[i] = i
+		}
+		return idx
+	}
+	if k < 2 {
+		return []int{n - 1}
+	}
+	idx := make([]int, k)
+	for i := range idx {
+		idx[i] = i * (n - 1) / (k - 1)
+	}
+	return idx
+}
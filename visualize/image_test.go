@@ -0,0 +1,162 @@
+package visualize
+
+import (
+	"bytes"
+	"encoding/xml"
+	"image/gif"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/RaviParvadiya/learn_dsa/sorting"
+	"github.com/RaviParvadiya/learn_dsa/trace"
+)
+
+func TestSample(t *testing.T) {
+	tests := []struct {
+		n, k int
+		want []int
+	}{
+		{0, 5, []int{}},
+		{3, 5, []int{0, 1, 2}},
+		{5, 5, []int{0, 1, 2, 3, 4}},
+		{10, 2, []int{0, 9}},
+		{10, 4, []int{0, 3, 6, 9}},
+		{7, 1, []int{6}},
+		{7, 0, nil},
+	}
+	for _, tt := range tests {
+		if got := sample(tt.n, tt.k); !slices.Equal(got, tt.want) {
+			t.Errorf("sample(%d, %d) = %v, want %v", tt.n, tt.k, got, tt.want)
+		}
+	}
+
+	for n := 1; n < 60; n++ {
+		for k := 2; k < 20; k++ {
+			idx := sample(n, k)
+			if len(idx) != min(n, k) || idx[0] != 0 || idx[len(idx)-1] != n-1 {
+				t.Fatalf("sample(%d, %d) = %v, want %d items from 0 to %d", n, k, idx, min(n, k), n-1)
+			}
+			for i := 1; i < len(idx); i++ {
+				if idx[i] <= idx[i-1] {
+					t.Fatalf("sample(%d, %d) = %v, not increasing", n, k, idx)
+				}
+			}
+		}
+	}
+}
+
+// record traces a real sort of 1..n reversed, so that every bar is drawn.
+func record(t *testing.T, name string, n int) *trace.Trace {
+	t.Helper()
+	input := make([]int, n)
+	for i := range input {
+		input[i] = n - i
+	}
+	for _, a := range sorting.Algorithms() {
+		if a.Name == name {
+			tr, _ := trace.Record(name, input, a.Sort)
+			return tr
+		}
+	}
+	t.Fatalf("no algorithm %s", name)
+	return nil
+}
+
+func TestWriteGIF(t *testing.T) {
+	tr := record(t, "InsertionSortBasic", 12)
+	var frames, changes int // frames with and without compares
+	for _, e := range tr.Events {
+		frames++
+		if e.Op != trace.OpCompare {
+			changes++
+		}
+	}
+	tests := []struct {
+		opts Options
+		want int
+	}{
+		{Options{Compares: true}, 1 + frames + 1},
+		{Options{}, 1 + changes + 1},
+		{Options{Compares: true, Frames: 10}, 10},
+		{Options{Frames: 2}, 2},
+		{Options{Frames: 1}, 1},
+	}
+	for _, tt := range tests {
+		opts := tt.opts
+		opts.Width, opts.Height = 60, 30
+		var buf bytes.Buffer
+		if err := WriteGIF(&buf, tr, opts); err != nil {
+			t.Fatal(err)
+		}
+		g, err := gif.DecodeAll(&buf)
+		if err != nil {
+			t.Fatalf("%+v: %v", tt.opts, err)
+		}
+		if len(g.Image) != tt.want {
+			t.Errorf("%+v: %d frames, want %d", tt.opts, len(g.Image), tt.want)
+			continue
+		}
+		if b := g.Image[0].Bounds(); b.Dx() != 60 || b.Dy() != 30 {
+			t.Errorf("%+v: frames are %v, want 60x30", tt.opts, b)
+		}
+		if last := g.Delay[len(g.Delay)-1]; last != 100 {
+			t.Errorf("%+v: output shown for %d, want 100", tt.opts, last)
+		}
+	}
+}
+
+// svgDoc is the part of an SVG filmstrip the tests look at.
+type svgDoc struct {
+	Frames []struct {
+		Label string `xml:"text"`
+		Rects []struct {
+			Fill string `xml:"fill,attr"`
+		} `xml:"rect"`
+	} `xml:"g"`
+}
+
+func TestWriteSVG(t *testing.T) {
+	tr := record(t, "MergeSort1", 16)
+	tr.Algorithm = `Merge <"&">`
+	merges := len(keySteps(tr, trace.OpSplit, trace.OpMerge))
+	for _, frames := range []int{0, 2, 5, merges + 2, merges + 10} {
+		var buf bytes.Buffer
+		if err := WriteSVG(&buf, tr, Options{Frames: frames}); err != nil {
+			t.Fatal(err)
+		}
+		var doc svgDoc
+		if err := xml.Unmarshal(buf.Bytes(), &doc); err != nil {
+			t.Fatalf("frames %d: not well-formed: %v", frames, err)
+		}
+		if frames == 0 {
+			frames = 12
+		}
+		if want := 2 + min(frames-2, merges); len(doc.Frames) != want {
+			t.Errorf("frames %d: %d frames, want %d", frames, len(doc.Frames), want)
+		}
+		for i, f := range doc.Frames {
+			if bars := len(f.Rects) - 1; bars != len(tr.Input) { // less the border
+				t.Errorf("frames %d: frame %d has %d bars, want %d", frames, i, bars, len(tr.Input))
+			}
+		}
+		first, last := doc.Frames[0], doc.Frames[len(doc.Frames)-1]
+		if first.Label != "input" || !strings.HasPrefix(last.Label, "output") {
+			t.Errorf("frames %d: labelled %q to %q", frames, first.Label, last.Label)
+		}
+	}
+
+	// Without splits or merges, the key frames are the swaps.
+	tr = record(t, "InsertionSortSwapping", 6)
+	var buf bytes.Buffer
+	if err := WriteSVG(&buf, tr, Options{Frames: 100}); err != nil {
+		t.Fatal(err)
+	}
+	var doc svgDoc
+	if err := xml.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatal(err)
+	}
+	if want := 2 + len(keySteps(tr, trace.OpSwap, trace.OpWrite)); len(doc.Frames) != want {
+		t.Errorf("swaps only: %d frames, want %d", len(doc.Frames), want)
+	}
+}
@@ -0,0 +1,117 @@
+package visualize
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"image/color"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/RaviParvadiya/learn_dsa/trace"
+)
+
+// Layout of the SVG filmstrip.
+const (
+	svgColumns = 4  // frames per row
+	svgLabel   = 20 // height of the label above each frame
+	svgPad     = 10 // space around frames
+)
+
+// WriteSVG writes t to w as a static SVG filmstrip: a grid of labelled bar
+// charts showing the input, at most opts.Frames-2 key frames and the output.
+// The key frames are taken at the splits and merges of the run, or at its
+// swaps and writes if it has none.
+func WriteSVG(w io.Writer, t *trace.Trace, opts Options) error {
+	opts = opts.withDefaults(12)
+	out, err := t.Replay(nil)
+	if err != nil {
+		return err
+	}
+
+	steps := keySteps(t, trace.OpSplit, trace.OpMerge)
+	if len(steps) == 0 {
+		steps = keySteps(t, trace.OpSwap, trace.OpWrite)
+	}
+	var keep []int
+	for _, i := range sample(len(steps), max(opts.Frames-2, 0)) {
+		keep = append(keep, steps[i])
+	}
+
+	frames := []Frame{{Step: -1, Values: t.Input, Low: -1, High: -1}}
+	err = Each(t, true, func(f Frame) bool {
+		if len(keep) > 0 && keep[0] == f.Step {
+			f.Values = slices.Clone(f.Values)
+			frames = append(frames, f)
+			keep = keep[1:]
+		}
+		return len(keep) > 0
+	})
+	if err != nil {
+		return err
+	}
+	frames = append(frames, final(t, out))
+
+	cols := min(len(frames), svgColumns)
+	rows := (len(frames) + cols - 1) / cols
+	cellW, cellH := opts.Width+svgPad, opts.Height+svgLabel+svgPad
+	maxVal := scale(t)
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" font-family="monospace" font-size="12">`+"\n",
+		cols*cellW+svgPad, rows*cellH+svgLabel+svgPad)
+	fmt.Fprintf(bw, `<rect width="100%%" height="100%%" fill="%s"/>`+"\n", hex(background))
+	fmt.Fprintf(bw, `<text x="%d" y="%d">%s</text>`+"\n", svgPad, svgLabel-4, escape(t.Algorithm))
+	for k, f := range frames {
+		x := svgPad + k%cols*cellW
+		y := svgLabel + svgPad + k/cols*cellH
+		fmt.Fprintf(bw, `<g transform="translate(%d,%d)">`+"\n", x, y)
+		fmt.Fprintf(bw, `<text y="%d">%s</text>`+"\n", svgLabel-6, escape(frameLabel(t, f)))
+		fmt.Fprintf(bw, `<rect y="%d" width="%d" height="%d" fill="none" stroke="%s"/>`+"\n",
+			svgLabel, opts.Width, opts.Height, hex(markColor[Outside]))
+		for i, v := range f.Values {
+			r := bar(i, len(f.Values), v, maxVal, opts.Width, opts.Height)
+			if r.Empty() {
+				continue
+			}
+			fmt.Fprintf(bw, `<rect x="%d" y="%d" width="%d" height="%d" fill="%s"/>`+"\n",
+				r.Min.X, svgLabel+r.Min.Y, r.Dx(), r.Dy(), hex(markColor[f.Highlight(i)]))
+		}
+		fmt.Fprintln(bw, `</g>`)
+	}
+	fmt.Fprintln(bw, `</svg>`)
+	return bw.Flush()
+}
+
+// keySteps returns the indices of the events of t with one of the given ops.
+func keySteps(t *trace.Trace, ops ...trace.Op) []int {
+	var steps []int
+	for i, e := range t.Events {
+		if slices.Contains(ops, e.Op) {
+			steps = append(steps, i)
+		}
+	}
+	return steps
+}
+
+// frameLabel names a frame of t by its position in the run.
+func frameLabel(t *trace.Trace, f Frame) string {
+	switch {
+	case f.Step < 0:
+		return "input"
+	case f.Step >= len(t.Events):
+		return fmt.Sprintf("output after %d steps", len(t.Events))
+	}
+	return fmt.Sprintf("%d: %s", f.Step+1, eventLabel(f))
+}
+
+func hex(c color.RGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
+func escape(s string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(s))
+	return b.String()
+}